@@ -21,6 +21,12 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 // DeleteBackupRequestSpec is the specification for which backups to delete.
 type DeleteBackupRequestSpec struct {
 	BackupName string `json:"backupName"`
+
+	// CascadeRestores specifies whether the restores created from the backup
+	// are deleted in all the namespaces in the same request as the backup,
+	// instead of only the restores in the namespace of the backup.
+	// +optional
+	CascadeRestores bool `json:"cascadeRestores,omitempty"`
 }
 
 // DeleteBackupRequestPhase represents the lifecycle phase of a DeleteBackupRequest.
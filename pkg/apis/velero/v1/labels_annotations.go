@@ -23,6 +23,10 @@ const (
 	// BackupUIDLabel is the label key used to identify a backup by uid.
 	BackupUIDLabel = "velero.io/backup-uid"
 
+	// DeleteBackupRequestCascadeRestoresLabel is the label key used to identify the DeleteBackupRequests
+	// which cascade the deletion to the restores created from the backup.
+	DeleteBackupRequestCascadeRestoresLabel = "velero.io/cascade-restores"
+
 	// RestoreNameLabel is the label key used to identify a restore by name.
 	RestoreNameLabel = "velero.io/restore-name"
 
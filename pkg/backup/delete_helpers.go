@@ -17,34 +17,96 @@ limitations under the License.
 package backup
 
 import (
-	"fmt"
-
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
 )
 
+// DeleteBackupRequestLabels returns the labels NewDeleteBackupRequest stamps on the DeleteBackupRequest for the backup
+// identified by name and uid, i.e., BackupNameLabel and BackupUIDLabel.
+func DeleteBackupRequestLabels(name string, uid string) map[string]string {
+	return map[string]string{
+		velerov1api.BackupNameLabel: label.GetValidName(name),
+		velerov1api.BackupUIDLabel:  uid,
+	}
+}
+
+// DeleteOptions defines the options of the DeleteBackupRequest created by NewDeleteBackupRequestWithOptions.
+type DeleteOptions struct {
+	// CascadeRestores specifies whether the restores created from the backup are deleted in all the namespaces,
+	// instead of only the restores in the namespace of the backup.
+	CascadeRestores bool
+}
+
 // NewDeleteBackupRequest creates a DeleteBackupRequest for the backup identified by name and uid.
 func NewDeleteBackupRequest(name string, uid string) *velerov1api.DeleteBackupRequest {
-	return &velerov1api.DeleteBackupRequest{
+	return NewDeleteBackupRequestWithOptions(name, uid, DeleteOptions{})
+}
+
+// NewDeleteBackupRequestWithOptions creates a DeleteBackupRequest for the backup identified by name and uid
+// with the specified options. The request cascading to restores is labeled with DeleteBackupRequestCascadeRestoresLabel.
+func NewDeleteBackupRequestWithOptions(name string, uid string, opts DeleteOptions) *velerov1api.DeleteBackupRequest {
+	req := &velerov1api.DeleteBackupRequest{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: name + "-",
-			Labels: map[string]string{
-				velerov1api.BackupNameLabel: label.GetValidName(name),
-				velerov1api.BackupUIDLabel:  uid,
-			},
+			Labels:       DeleteBackupRequestLabels(name, uid),
 		},
 		Spec: velerov1api.DeleteBackupRequestSpec{
-			BackupName: name,
+			BackupName:      name,
+			CascadeRestores: opts.CascadeRestores,
 		},
 	}
+
+	if opts.CascadeRestores {
+		req.Labels[velerov1api.DeleteBackupRequestCascadeRestoresLabel] = "true"
+	}
+
+	return req
 }
 
 // NewDeleteBackupRequestListOptions creates a ListOptions with a label selector configured to
-// find DeleteBackupRequests for the backup identified by name and uid.
+// find DeleteBackupRequests for the backup identified by name and uid, by the labels of DeleteBackupRequestLabels.
 func NewDeleteBackupRequestListOptions(name, uid string) metav1.ListOptions {
 	return metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", velerov1api.BackupNameLabel, label.GetValidName(name), velerov1api.BackupUIDLabel, uid),
+		LabelSelector: labels.SelectorFromSet(DeleteBackupRequestLabels(name, uid)).String(),
+	}
+}
+
+// NewDeleteBackupRequestListOptionsByUID creates a ListOptions with a label selector configured to
+// find DeleteBackupRequests for the backup identified by uid only, so that the requests for a prior
+// backup with the same name are not matched. uid is required, otherwise the requests of any backup would be matched.
+func NewDeleteBackupRequestListOptionsByUID(uid string) (metav1.ListOptions, error) {
+	if uid == "" {
+		return metav1.ListOptions{}, errors.New("backup uid is required to list delete backup requests")
+	}
+
+	return metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{velerov1api.BackupUIDLabel: uid}).String(),
+	}, nil
+}
+
+// NewDeleteBackupRequestsForSchedule creates one DeleteBackupRequest for each of the backups created by the schedule,
+// e.g., to delete all the backups of a retired schedule. Each request is labeled with ScheduleNameLabel besides
+// the labels of DeleteBackupRequestLabels.
+func NewDeleteBackupRequestsForSchedule(scheduleName string, backupNames []string) []*velerov1api.DeleteBackupRequest {
+	reqs := make([]*velerov1api.DeleteBackupRequest, 0, len(backupNames))
+	for _, name := range backupNames {
+		req := NewDeleteBackupRequest(name, "")
+		req.Labels[velerov1api.ScheduleNameLabel] = label.GetValidName(scheduleName)
+
+		reqs = append(reqs, req)
+	}
+
+	return reqs
+}
+
+// NewDeleteBackupRequestListOptionsForSchedule creates a ListOptions with a label selector configured to
+// find DeleteBackupRequests created by NewDeleteBackupRequestsForSchedule for the schedule identified by name.
+func NewDeleteBackupRequestListOptionsForSchedule(scheduleName string) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{velerov1api.ScheduleNameLabel: label.GetValidName(scheduleName)}).String(),
 	}
 }
@@ -0,0 +1,165 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestNewDeleteBackupRequest(t *testing.T) {
+	req := NewDeleteBackupRequest("fake-backup", "fake-uid")
+
+	assert.Equal(t, "fake-backup-", req.GenerateName)
+	assert.Equal(t, map[string]string{
+		velerov1api.BackupNameLabel: "fake-backup",
+		velerov1api.BackupUIDLabel:  "fake-uid",
+	}, req.Labels)
+	assert.Equal(t, "fake-backup", req.Spec.BackupName)
+}
+
+func TestNewDeleteBackupRequestWithOptions(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           DeleteOptions
+		expectedLabels map[string]string
+	}{
+		{
+			name: "default options",
+			expectedLabels: map[string]string{
+				velerov1api.BackupNameLabel: "fake-backup",
+				velerov1api.BackupUIDLabel:  "fake-uid",
+			},
+		},
+		{
+			name: "cascade restores",
+			opts: DeleteOptions{CascadeRestores: true},
+			expectedLabels: map[string]string{
+				velerov1api.BackupNameLabel:                         "fake-backup",
+				velerov1api.BackupUIDLabel:                          "fake-uid",
+				velerov1api.DeleteBackupRequestCascadeRestoresLabel: "true",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := NewDeleteBackupRequestWithOptions("fake-backup", "fake-uid", test.opts)
+
+			assert.Equal(t, "fake-backup-", req.GenerateName)
+			assert.Equal(t, test.expectedLabels, req.Labels)
+			assert.Equal(t, "fake-backup", req.Spec.BackupName)
+			assert.Equal(t, test.opts.CascadeRestores, req.Spec.CascadeRestores)
+		})
+	}
+
+	assert.Equal(t, NewDeleteBackupRequestWithOptions("fake-backup", "fake-uid", DeleteOptions{}), NewDeleteBackupRequest("fake-backup", "fake-uid"))
+}
+
+func TestNewDeleteBackupRequestWithoutUID(t *testing.T) {
+	req := NewDeleteBackupRequest("fake-backup", "")
+
+	assert.Equal(t, map[string]string{
+		velerov1api.BackupNameLabel: "fake-backup",
+		velerov1api.BackupUIDLabel:  "",
+	}, req.Labels)
+}
+
+func TestDeleteBackupRequestLabels(t *testing.T) {
+	assert.Equal(t, NewDeleteBackupRequest("fake-backup", "fake-uid").Labels, DeleteBackupRequestLabels("fake-backup", "fake-uid"))
+	assert.Equal(t, NewDeleteBackupRequest("fake-backup", "").Labels, DeleteBackupRequestLabels("fake-backup", ""))
+}
+
+func TestNewDeleteBackupRequestListOptions(t *testing.T) {
+	opts := NewDeleteBackupRequestListOptions("fake-backup", "fake-uid")
+	assert.Equal(t, "velero.io/backup-name=fake-backup,velero.io/backup-uid=fake-uid", opts.LabelSelector)
+
+	selector, err := labels.Parse(opts.LabelSelector)
+	require.NoError(t, err)
+
+	assert.True(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup", "fake-uid").Labels)))
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup", "other-uid").Labels)))
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequest("other-backup", "fake-uid").Labels)))
+}
+
+func TestNewDeleteBackupRequestListOptionsWithoutUID(t *testing.T) {
+	opts := NewDeleteBackupRequestListOptions("fake-backup", "")
+	assert.Equal(t, "velero.io/backup-name=fake-backup,velero.io/backup-uid=", opts.LabelSelector)
+
+	selector, err := labels.Parse(opts.LabelSelector)
+	require.NoError(t, err)
+
+	assert.True(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup", "").Labels)))
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup", "fake-uid").Labels)))
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequest("other-backup", "").Labels)))
+}
+
+func TestNewDeleteBackupRequestListOptionsByUID(t *testing.T) {
+	opts, err := NewDeleteBackupRequestListOptionsByUID("fake-uid")
+	require.NoError(t, err)
+	assert.Equal(t, "velero.io/backup-uid=fake-uid", opts.LabelSelector)
+
+	selector, err := labels.Parse(opts.LabelSelector)
+	require.NoError(t, err)
+
+	assert.True(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup", "fake-uid").Labels)))
+	assert.True(t, selector.Matches(labels.Set(NewDeleteBackupRequest("other-backup", "fake-uid").Labels)))
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup", "other-uid").Labels)))
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup", "").Labels)))
+}
+
+func TestNewDeleteBackupRequestListOptionsByEmptyUID(t *testing.T) {
+	_, err := NewDeleteBackupRequestListOptionsByUID("")
+	require.EqualError(t, err, "backup uid is required to list delete backup requests")
+}
+
+func TestNewDeleteBackupRequestsForSchedule(t *testing.T) {
+	reqs := NewDeleteBackupRequestsForSchedule("fake-schedule", []string{"fake-schedule-20240101000000", "fake-schedule-20240102000000"})
+	require.Len(t, reqs, 2)
+
+	for i, name := range []string{"fake-schedule-20240101000000", "fake-schedule-20240102000000"} {
+		assert.Equal(t, name+"-", reqs[i].GenerateName)
+		assert.Equal(t, name, reqs[i].Spec.BackupName)
+		assert.Equal(t, map[string]string{
+			velerov1api.BackupNameLabel:   name,
+			velerov1api.BackupUIDLabel:    "",
+			velerov1api.ScheduleNameLabel: "fake-schedule",
+		}, reqs[i].Labels)
+	}
+
+	assert.Empty(t, NewDeleteBackupRequestsForSchedule("fake-schedule", nil))
+}
+
+func TestNewDeleteBackupRequestListOptionsForSchedule(t *testing.T) {
+	opts := NewDeleteBackupRequestListOptionsForSchedule("fake-schedule")
+	assert.Equal(t, "velero.io/schedule-name=fake-schedule", opts.LabelSelector)
+
+	selector, err := labels.Parse(opts.LabelSelector)
+	require.NoError(t, err)
+
+	for _, req := range NewDeleteBackupRequestsForSchedule("fake-schedule", []string{"fake-backup-1", "fake-backup-2"}) {
+		assert.True(t, selector.Matches(labels.Set(req.Labels)))
+	}
+
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequestsForSchedule("other-schedule", []string{"fake-backup-1"})[0].Labels)))
+	assert.False(t, selector.Matches(labels.Set(NewDeleteBackupRequest("fake-backup-1", "fake-uid").Labels)))
+}
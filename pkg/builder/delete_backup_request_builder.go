@@ -62,6 +62,12 @@ func (b *DeleteBackupRequestBuilder) BackupName(name string) *DeleteBackupReques
 	return b
 }
 
+// CascadeRestores sets whether the DeleteBackupRequest cascades to the restores created from the backup.
+func (b *DeleteBackupRequestBuilder) CascadeRestores(cascade bool) *DeleteBackupRequestBuilder {
+	b.object.Spec.CascadeRestores = cascade
+	return b
+}
+
 // Phase sets the DeleteBackupRequest's phase.
 func (b *DeleteBackupRequestBuilder) Phase(phase velerov1api.DeleteBackupRequestPhase) *DeleteBackupRequestBuilder {
 	b.object.Status.Phase = phase
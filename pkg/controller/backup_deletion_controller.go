@@ -363,8 +363,13 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	log.Info("Removing restores")
 	restoreList := &velerov1api.RestoreList{}
 	selector := labels.Everything()
+	// The restores in all the namespaces are listed if the request cascades to restores.
+	restoreNamespace := backup.Namespace
+	if dbr.Spec.CascadeRestores {
+		restoreNamespace = ""
+	}
 	if err := r.List(ctx, restoreList, &client.ListOptions{
-		Namespace:     backup.Namespace,
+		Namespace:     restoreNamespace,
 		LabelSelector: selector,
 	}); err != nil {
 		log.WithError(errors.WithStack(err)).Error("Error listing restore API objects")
@@ -387,7 +392,7 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		// Therefore, it is advisable to set a timeout period for waiting.
 		err := wait.PollUntilContextTimeout(ctx, time.Second, time.Minute, true, func(ctx context.Context) (bool, error) {
 			restoreList := &velerov1api.RestoreList{}
-			if err := r.List(ctx, restoreList, &client.ListOptions{Namespace: backup.Namespace, LabelSelector: selector}); err != nil {
+			if err := r.List(ctx, restoreList, &client.ListOptions{Namespace: restoreNamespace, LabelSelector: selector}); err != nil {
 				return false, err
 			}
 			cnt := 0
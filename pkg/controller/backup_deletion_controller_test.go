@@ -521,6 +521,60 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		// Make sure snapshot was deleted
 		assert.Equal(t, 0, td.volumeSnapshotter.SnapshotsTaken.Len())
 	})
+	t.Run("full delete, restores in other namespaces are only deleted with cascade restores", func(t *testing.T) {
+		for _, cascade := range []bool{false, true} {
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").Result()
+			backup.UID = "uid"
+			backup.Spec.StorageLocation = "primary"
+
+			restore1 := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseCompleted).Backup("foo").Result()
+			restore2 := builder.ForRestore("other-ns", "restore-2").Phase(velerov1api.RestorePhaseCompleted).Backup("foo").Result()
+			restore3 := builder.ForRestore("other-ns", "restore-3").Phase(velerov1api.RestorePhaseCompleted).Backup("some-other-backup").Result()
+
+			dbr := pkgbackup.NewDeleteBackupRequestWithOptions(backup.Name, "uid", pkgbackup.DeleteOptions{CascadeRestores: cascade})
+			dbr.Namespace = velerov1api.DefaultNamespace
+			dbr.Name = "foo-abcde"
+
+			location := builder.ForBackupStorageLocation(backup.Namespace, backup.Spec.StorageLocation).Provider("objStoreProvider").Bucket("bucket").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+
+			td := setupBackupDeletionControllerTest(t, dbr, backup, restore1, restore2, restore3, location)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("GetDeleteItemActions").Return(nil, nil)
+			pluginManager.On("CleanupClients")
+			td.controller.newPluginManager = func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager }
+
+			td.backupStore.On("GetBackupVolumeSnapshots", dbr.Spec.BackupName).Return(nil, nil)
+			td.backupStore.On("DeleteBackup", dbr.Spec.BackupName).Return(nil)
+
+			_, err := td.controller.Reconcile(context.TODO(), td.req)
+			require.NoError(t, err)
+
+			err = td.fakeClient.Get(context.TODO(), types.NamespacedName{
+				Namespace: velerov1api.DefaultNamespace,
+				Name:      "restore-1",
+			}, &velerov1api.Restore{})
+			assert.True(t, apierrors.IsNotFound(err), "Expected not found error, but actual value of error: %v", err)
+
+			// restore-2 is only deleted if the request cascades to restores
+			err = td.fakeClient.Get(context.TODO(), types.NamespacedName{
+				Namespace: "other-ns",
+				Name:      "restore-2",
+			}, &velerov1api.Restore{})
+			if cascade {
+				assert.True(t, apierrors.IsNotFound(err), "Expected not found error, but actual value of error: %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			// restore-3 should remain
+			err = td.fakeClient.Get(context.TODO(), types.NamespacedName{
+				Namespace: "other-ns",
+				Name:      "restore-3",
+			}, &velerov1api.Restore{})
+			require.NoError(t, err)
+		}
+	})
 	t.Run("backup is not downloaded when there are no DeleteItemAction plugins", func(t *testing.T) {
 		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").Result()
 		backup.UID = "uid"
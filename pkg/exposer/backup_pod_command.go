@@ -0,0 +1,60 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"fmt"
+	"time"
+
+	corev1api "k8s.io/api/core/v1"
+)
+
+// BackupPodCommandBuilder builds the command and args of the backup container, e.g., to run an alternative data mover
+type BackupPodCommandBuilder interface {
+	// Build returns the command and args to back up the volume at volumePath in volumeMode for the owner named ownerName,
+	// with timeout as the timeout of the resource operations.
+	// The log format and log level args inherited from node-agent are appended to the returned args
+	Build(volumePath string, volumeMode corev1api.PersistentVolumeMode, ownerName string, timeout time.Duration) (command []string, args []string)
+}
+
+// DefaultBackupPodCommandBuilder builds the command running the Velero data mover backup, or the data mover restore for ExposeDirectionRestore
+type DefaultBackupPodCommandBuilder struct {
+	// Direction specifies the direction of the data mover, if it is empty, ExposeDirectionBackup is used
+	Direction ExposeDirection
+}
+
+func (b DefaultBackupPodCommandBuilder) Build(volumePath string, volumeMode corev1api.PersistentVolumeMode, ownerName string, timeout time.Duration) ([]string, []string) {
+	subCommand, ownerFlag := "backup", "data-upload"
+	if b.Direction == ExposeDirectionRestore {
+		subCommand, ownerFlag = "restore", "data-download"
+	}
+
+	command := []string{
+		"/velero",
+		"data-mover",
+		subCommand,
+	}
+
+	args := []string{
+		fmt.Sprintf("--volume-path=%s", volumePath),
+		fmt.Sprintf("--volume-mode=%s", volumeMode),
+		fmt.Sprintf("--%s=%s", ownerFlag, ownerName),
+		fmt.Sprintf("--resource-timeout=%s", timeout.String()),
+	}
+
+	return command, args
+}
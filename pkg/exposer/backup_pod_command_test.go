@@ -0,0 +1,152 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// experimentalCommandBuilder runs an alternative mover binary with an experimental flag appended to the default args
+type experimentalCommandBuilder struct {
+	volumePath string
+	volumeMode corev1api.PersistentVolumeMode
+	ownerName  string
+	timeout    time.Duration
+}
+
+func (b *experimentalCommandBuilder) Build(volumePath string, volumeMode corev1api.PersistentVolumeMode, ownerName string, timeout time.Duration) ([]string, []string) {
+	b.volumePath = volumePath
+	b.volumeMode = volumeMode
+	b.ownerName = ownerName
+	b.timeout = timeout
+
+	_, args := DefaultBackupPodCommandBuilder{}.Build(volumePath, volumeMode, ownerName, timeout)
+
+	return []string{"/velero-next", "backup"}, append(args, "--experimental")
+}
+
+func TestBackupPodCommandBuilder(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+							Args: []string{"--log-format=json", "--log-level=debug"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	param := &CSISnapshotExposeParam{
+		OperationTimeout: time.Minute,
+	}
+
+	defaultExposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger()).(*csiSnapshotExposer)
+
+	pod, err := defaultExposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/velero", "data-mover", "backup"}, pod.Spec.Containers[0].Command)
+	assert.Equal(t, []string{
+		"--volume-path=/fake-uid",
+		"--volume-mode=Filesystem",
+		"--data-upload=fake-backup",
+		"--resource-timeout=1m0s",
+		"--log-format=json",
+		"--log-level=debug",
+	}, pod.Spec.Containers[0].Args)
+
+	restoreParam := &CSISnapshotExposeParam{
+		OperationTimeout: time.Minute,
+		Direction:        ExposeDirectionRestore,
+	}
+
+	restoreExposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger()).(*csiSnapshotExposer)
+
+	pod, err = restoreExposer.createBackupPod(context.Background(), ownerObject, backupPVC, restoreParam, false, false, kube.NodeOSLinux, "", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/velero", "data-mover", "restore"}, pod.Spec.Containers[0].Command)
+	assert.Equal(t, []string{
+		"--volume-path=/fake-uid",
+		"--volume-mode=Filesystem",
+		"--data-download=fake-backup",
+		"--resource-timeout=1m0s",
+		"--log-format=json",
+		"--log-level=debug",
+	}, pod.Spec.Containers[0].Args)
+
+	builder := &experimentalCommandBuilder{}
+	customExposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(),
+		WithBackupPodCommandBuilder(builder)).(*csiSnapshotExposer)
+
+	pod, err = customExposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/fake-uid", builder.volumePath)
+	assert.Equal(t, corev1api.PersistentVolumeFilesystem, builder.volumeMode)
+	assert.Equal(t, "fake-backup", builder.ownerName)
+	assert.Equal(t, time.Minute, builder.timeout)
+
+	assert.Equal(t, []string{"/velero-next", "backup"}, pod.Spec.Containers[0].Command)
+	assert.Equal(t, []string{
+		"--volume-path=/fake-uid",
+		"--volume-mode=Filesystem",
+		"--data-upload=fake-backup",
+		"--resource-timeout=1m0s",
+		"--experimental",
+		"--log-format=json",
+		"--log-level=debug",
+	}, pod.Spec.Containers[0].Args)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var (
+	recommendedCPURequest    = resource.MustParse("500m")
+	recommendedCPULimit      = resource.MustParse("2")
+	recommendedMemoryBase    = resource.MustParse("256Mi")
+	recommendedMemoryMax     = resource.MustParse("4Gi")
+	recommendedMemoryPerStep = map[corev1api.PersistentVolumeMode]resource.Quantity{
+		corev1api.PersistentVolumeFilesystem: resource.MustParse("64Mi"),
+		corev1api.PersistentVolumeBlock:      resource.MustParse("32Mi"),
+	}
+	recommendedMemoryStep = resource.MustParse("100Gi")
+)
+
+// BackupPodResourceRecommender estimates the resource requirements of the backup pod, it is used when the caller doesn't specify any
+type BackupPodResourceRecommender interface {
+	// RecommendResources returns the resource requirements to back up a volume of volumeSize in mode
+	RecommendResources(volumeSize resource.Quantity, mode corev1api.PersistentVolumeMode) corev1api.ResourceRequirements
+}
+
+// DefaultBackupPodResourceRecommender recommends the resource requirements by RecommendResources
+type DefaultBackupPodResourceRecommender struct{}
+
+func (DefaultBackupPodResourceRecommender) RecommendResources(volumeSize resource.Quantity, mode corev1api.PersistentVolumeMode) corev1api.ResourceRequirements {
+	return RecommendResources(volumeSize, mode)
+}
+
+// RecommendResources returns a heuristic of the resource requirements of the backup pod by the volume size.
+// CPU is fixed, since the data mover throughput is bound by the disk and network rather than the volume size;
+// memory grows modestly with the volume size for the uploader's index and metadata cache, less for block mode
+// which is uploaded as a single file, and is capped so that a huge volume doesn't make the pod unschedulable.
+// The memory limit is twice the request
+func RecommendResources(volumeSize resource.Quantity, mode corev1api.PersistentVolumeMode) corev1api.ResourceRequirements {
+	perStep, found := recommendedMemoryPerStep[mode]
+	if !found {
+		perStep = recommendedMemoryPerStep[corev1api.PersistentVolumeFilesystem]
+	}
+
+	memory := recommendedMemoryBase.DeepCopy()
+	if volumeSize.Sign() > 0 {
+		steps := (volumeSize.Value() + recommendedMemoryStep.Value() - 1) / recommendedMemoryStep.Value()
+		memory.Add(*resource.NewQuantity(steps*perStep.Value(), resource.BinarySI))
+	}
+
+	if memory.Cmp(recommendedMemoryMax) > 0 {
+		memory = recommendedMemoryMax.DeepCopy()
+	}
+
+	memoryLimit := *resource.NewQuantity(memory.Value()*2, resource.BinarySI)
+
+	return corev1api.ResourceRequirements{
+		Requests: corev1api.ResourceList{
+			corev1api.ResourceCPU:    recommendedCPURequest.DeepCopy(),
+			corev1api.ResourceMemory: memory,
+		},
+		Limits: corev1api.ResourceList{
+			corev1api.ResourceCPU:    recommendedCPULimit.DeepCopy(),
+			corev1api.ResourceMemory: memoryLimit,
+		},
+	}
+}
+
+// isResourceRequirementsEmpty returns true if none of requests, limits or claims is specified
+func isResourceRequirementsEmpty(resources corev1api.ResourceRequirements) bool {
+	return len(resources.Requests) == 0 && len(resources.Limits) == 0 && len(resources.Claims) == 0
+}
@@ -0,0 +1,187 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func TestRecommendResources(t *testing.T) {
+	tests := []struct {
+		name           string
+		volumeSize     string
+		mode           corev1api.PersistentVolumeMode
+		expectedMemory string
+		expectedLimit  string
+	}{
+		{
+			name:           "empty volume size",
+			volumeSize:     "0",
+			mode:           corev1api.PersistentVolumeFilesystem,
+			expectedMemory: "256Mi",
+			expectedLimit:  "512Mi",
+		},
+		{
+			name:           "small filesystem volume",
+			volumeSize:     "10Gi",
+			mode:           corev1api.PersistentVolumeFilesystem,
+			expectedMemory: "320Mi",
+			expectedLimit:  "640Mi",
+		},
+		{
+			name:           "filesystem volume of 1Ti",
+			volumeSize:     "1Ti",
+			mode:           corev1api.PersistentVolumeFilesystem,
+			expectedMemory: "960Mi",
+			expectedLimit:  "1920Mi",
+		},
+		{
+			name:           "block volume of 1Ti",
+			volumeSize:     "1Ti",
+			mode:           corev1api.PersistentVolumeBlock,
+			expectedMemory: "608Mi",
+			expectedLimit:  "1216Mi",
+		},
+		{
+			name:           "unknown mode is taken as filesystem",
+			volumeSize:     "100Gi",
+			mode:           "",
+			expectedMemory: "320Mi",
+			expectedLimit:  "640Mi",
+		},
+		{
+			name:           "huge volume is capped",
+			volumeSize:     "100Ti",
+			mode:           corev1api.PersistentVolumeFilesystem,
+			expectedMemory: "4Gi",
+			expectedLimit:  "8Gi",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resources := RecommendResources(resource.MustParse(test.volumeSize), test.mode)
+
+			assert.Equal(t, 0, resources.Requests.Cpu().Cmp(resource.MustParse("500m")))
+			assert.Equal(t, 0, resources.Limits.Cpu().Cmp(resource.MustParse("2")))
+			assert.Equal(t, 0, resources.Requests.Memory().Cmp(resource.MustParse(test.expectedMemory)), "memory request %s", resources.Requests.Memory())
+			assert.Equal(t, 0, resources.Limits.Memory().Cmp(resource.MustParse(test.expectedLimit)), "memory limit %s", resources.Limits.Memory())
+		})
+	}
+}
+
+type fixedResourceRecommender struct {
+	volumeSize resource.Quantity
+	mode       corev1api.PersistentVolumeMode
+}
+
+func (r *fixedResourceRecommender) RecommendResources(volumeSize resource.Quantity, mode corev1api.PersistentVolumeMode) corev1api.ResourceRequirements {
+	r.volumeSize = volumeSize
+	r.mode = mode
+
+	return corev1api.ResourceRequirements{
+		Requests: corev1api.ResourceList{
+			corev1api.ResourceMemory: resource.MustParse("1Gi"),
+		},
+	}
+}
+
+func TestBackupPodResourceRecommender(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	blockMode := corev1api.PersistentVolumeBlock
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeMode: &blockMode,
+			Resources: corev1api.VolumeResourceRequirements{
+				Requests: corev1api.ResourceList{
+					corev1api.ResourceStorage: resource.MustParse("200Gi"),
+				},
+			},
+		},
+	}
+
+	specified := corev1api.ResourceRequirements{
+		Limits: corev1api.ResourceList{
+			corev1api.ResourceCPU: resource.MustParse("1"),
+		},
+	}
+
+	createBackupPod := func(param *CSISnapshotExposeParam, opts ...CSISnapshotExposerOption) *corev1api.Pod {
+		exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), opts...).(*csiSnapshotExposer)
+
+		pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+		require.NoError(t, err)
+
+		return pod
+	}
+
+	pod := createBackupPod(&CSISnapshotExposeParam{OperationTimeout: time.Minute})
+	assert.Equal(t, RecommendResources(resource.MustParse("200Gi"), corev1api.PersistentVolumeBlock), pod.Spec.Containers[0].Resources)
+
+	pod = createBackupPod(&CSISnapshotExposeParam{OperationTimeout: time.Minute, Resources: specified})
+	assert.Equal(t, specified, pod.Spec.Containers[0].Resources)
+
+	recommender := &fixedResourceRecommender{}
+	pod = createBackupPod(&CSISnapshotExposeParam{OperationTimeout: time.Minute}, WithBackupPodResourceRecommender(recommender))
+	assert.Equal(t, resource.MustParse("200Gi"), recommender.volumeSize)
+	assert.Equal(t, corev1api.PersistentVolumeBlock, recommender.mode)
+	assert.Equal(t, resource.MustParse("1Gi"), pod.Spec.Containers[0].Resources.Requests[corev1api.ResourceMemory])
+}
@@ -0,0 +1,105 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+)
+
+const defaultBackupPVCConfigCacheTTL = 30 * time.Second
+
+// backupPVCConfigLoader loads the backupPVC config from a ConfigMap and caches it for a short period,
+// so that the changes to the ConfigMap take effect without restarting, but the ConfigMap is not read for every expose
+type backupPVCConfigLoader struct {
+	namespace string
+	name      string
+	ttl       time.Duration
+	clock     clock.Clock
+
+	lock    sync.Mutex
+	cached  map[string]nodeagent.BackupPVC
+	expires time.Time
+}
+
+func newBackupPVCConfigLoader(namespace string, name string, ttl time.Duration) *backupPVCConfigLoader {
+	return &backupPVCConfigLoader{
+		namespace: namespace,
+		name:      name,
+		ttl:       ttl,
+		clock:     clock.RealClock{},
+	}
+}
+
+// load returns the backupPVC config in the ConfigMap, the ConfigMap is only read when the cached one expires
+func (l *backupPVCConfigLoader) load(ctx context.Context, kubeClient kubernetes.Interface) (map[string]nodeagent.BackupPVC, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.cached != nil && l.clock.Now().Before(l.expires) {
+		return l.cached, nil
+	}
+
+	cm, err := kubeClient.CoreV1().ConfigMaps(l.namespace).Get(ctx, l.name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to get backupPVC config map %s/%s", l.namespace, l.name)
+	}
+
+	if len(cm.Data) != 1 {
+		return nil, errors.Errorf("config map %s/%s should have exactly one data item, actual %d", l.namespace, l.name, len(cm.Data))
+	}
+
+	configs := map[string]nodeagent.BackupPVC{}
+	for _, v := range cm.Data {
+		// yaml is a superset of json, so both formats are accepted
+		if err := yaml.Unmarshal([]byte(v), &configs); err != nil {
+			return nil, errors.Wrapf(err, "error to unmarshall backupPVC config from %s/%s", l.namespace, l.name)
+		}
+	}
+
+	l.cached = configs
+	l.expires = l.clock.Now().Add(l.ttl)
+
+	return configs, nil
+}
+
+// mergeBackupPVCConfig merges the backupPVC config from the ConfigMap and the inline one, the inline one wins for the same storage class
+func mergeBackupPVCConfig(fromConfigMap map[string]nodeagent.BackupPVC, inline map[string]nodeagent.BackupPVC) map[string]nodeagent.BackupPVC {
+	if len(fromConfigMap) == 0 {
+		return inline
+	}
+
+	merged := make(map[string]nodeagent.BackupPVC, len(fromConfigMap)+len(inline))
+	for k, v := range fromConfigMap {
+		merged[k] = v
+	}
+
+	for k, v := range inline {
+		merged[k] = v
+	}
+
+	return merged
+}
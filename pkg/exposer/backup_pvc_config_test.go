@@ -0,0 +1,196 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	testclocks "k8s.io/utils/clock/testing"
+
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+)
+
+func TestBackupPVCConfigLoaderLoad(t *testing.T) {
+	configMap := func(data map[string]string) *corev1api.ConfigMap {
+		return &corev1api.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      "backup-pvc-config",
+			},
+			Data: data,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		expected      map[string]nodeagent.BackupPVC
+		expectedErr   string
+	}{
+		{
+			name:        "config map not found",
+			expectedErr: "error to get backupPVC config map velero/backup-pvc-config: configmaps \"backup-pvc-config\" not found",
+		},
+		{
+			name:          "no data",
+			kubeClientObj: []runtime.Object{configMap(nil)},
+			expectedErr:   "config map velero/backup-pvc-config should have exactly one data item, actual 0",
+		},
+		{
+			name: "malformed data",
+			kubeClientObj: []runtime.Object{configMap(map[string]string{
+				"config": "{\"fake-sc\": [",
+			})},
+			expectedErr: "error to unmarshall backupPVC config from velero/backup-pvc-config: error converting YAML to JSON: yaml: line 1: did not find expected node content",
+		},
+		{
+			name: "json data",
+			kubeClientObj: []runtime.Object{configMap(map[string]string{
+				"config": `{"fake-sc": {"storageClass": "fake-backup-sc", "readOnly": true}}`,
+			})},
+			expected: map[string]nodeagent.BackupPVC{
+				"fake-sc": {
+					StorageClass: "fake-backup-sc",
+					ReadOnly:     true,
+				},
+			},
+		},
+		{
+			name: "yaml data",
+			kubeClientObj: []runtime.Object{configMap(map[string]string{
+				"config": "fake-sc:\n  storageClass: fake-backup-sc\n  readOnly: true\n  spcNoRelabeling: true\n",
+			})},
+			expected: map[string]nodeagent.BackupPVC{
+				"fake-sc": {
+					StorageClass:    "fake-backup-sc",
+					ReadOnly:        true,
+					SPCNoRelabeling: true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(tt.kubeClientObj...)
+			loader := newBackupPVCConfigLoader("velero", "backup-pvc-config", time.Minute)
+
+			configs, err := loader.load(context.Background(), fakeKubeClient)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				assert.Nil(t, loader.cached)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, configs)
+		})
+	}
+}
+
+func TestBackupPVCConfigLoaderCache(t *testing.T) {
+	cm := &corev1api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "backup-pvc-config",
+		},
+		Data: map[string]string{
+			"config": `{"fake-sc": {"storageClass": "fake-backup-sc-1"}}`,
+		},
+	}
+
+	fakeKubeClient := fake.NewSimpleClientset(cm)
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	loader := newBackupPVCConfigLoader("velero", "backup-pvc-config", time.Minute)
+	loader.clock = fakeClock
+
+	configs, err := loader.load(context.Background(), fakeKubeClient)
+	require.NoError(t, err)
+	assert.Equal(t, "fake-backup-sc-1", configs["fake-sc"].StorageClass)
+
+	cm.Data["config"] = `{"fake-sc": {"storageClass": "fake-backup-sc-2"}}`
+	_, err = fakeKubeClient.CoreV1().ConfigMaps(cm.Namespace).Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	fakeClock.Step(30 * time.Second)
+	configs, err = loader.load(context.Background(), fakeKubeClient)
+	require.NoError(t, err)
+	assert.Equal(t, "fake-backup-sc-1", configs["fake-sc"].StorageClass)
+
+	fakeClock.Step(31 * time.Second)
+	configs, err = loader.load(context.Background(), fakeKubeClient)
+	require.NoError(t, err)
+	assert.Equal(t, "fake-backup-sc-2", configs["fake-sc"].StorageClass)
+}
+
+func TestMergeBackupPVCConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		fromConfigMap map[string]nodeagent.BackupPVC
+		inline        map[string]nodeagent.BackupPVC
+		expected      map[string]nodeagent.BackupPVC
+	}{
+		{
+			name: "no config map",
+			inline: map[string]nodeagent.BackupPVC{
+				"fake-sc-1": {StorageClass: "inline-sc"},
+			},
+			expected: map[string]nodeagent.BackupPVC{
+				"fake-sc-1": {StorageClass: "inline-sc"},
+			},
+		},
+		{
+			name: "no inline",
+			fromConfigMap: map[string]nodeagent.BackupPVC{
+				"fake-sc-1": {StorageClass: "cm-sc"},
+			},
+			expected: map[string]nodeagent.BackupPVC{
+				"fake-sc-1": {StorageClass: "cm-sc"},
+			},
+		},
+		{
+			name: "inline wins",
+			fromConfigMap: map[string]nodeagent.BackupPVC{
+				"fake-sc-1": {StorageClass: "cm-sc-1", ReadOnly: true},
+				"fake-sc-2": {StorageClass: "cm-sc-2"},
+			},
+			inline: map[string]nodeagent.BackupPVC{
+				"fake-sc-1": {StorageClass: "inline-sc-1"},
+				"fake-sc-3": {StorageClass: "inline-sc-3"},
+			},
+			expected: map[string]nodeagent.BackupPVC{
+				"fake-sc-1": {StorageClass: "inline-sc-1"},
+				"fake-sc-2": {StorageClass: "cm-sc-2"},
+				"fake-sc-3": {StorageClass: "inline-sc-3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mergeBackupPVCConfig(tt.fromConfigMap, tt.inline))
+		})
+	}
+}
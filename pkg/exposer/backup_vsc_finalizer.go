@@ -0,0 +1,68 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// BackupVSCExposeFinalizer is added to the backup VSC by Expose when it is enabled by WithBackupVSCFinalizer, so that the backup VSC
+// and the retained snapshot handle it refers to are not deleted before the backupPVC is bound, even if the source VS is deleted meanwhile
+const BackupVSCExposeFinalizer = "velero.io/expose-snapshot-protection"
+
+// addExposeFinalizer adds BackupVSCExposeFinalizer to the backup VSC if it doesn't exist
+func (e *csiSnapshotExposer) addExposeFinalizer(ctx context.Context, vscName string) error {
+	return e.updateBackupVSCFinalizer(ctx, vscName, func(obj client.Object) bool {
+		return controllerutil.AddFinalizer(obj, BackupVSCExposeFinalizer)
+	})
+}
+
+// removeExposeFinalizer removes BackupVSCExposeFinalizer from the backup VSC, it is a no-op if the backup VSC doesn't exist
+func (e *csiSnapshotExposer) removeExposeFinalizer(ctx context.Context, vscName string) error {
+	err := e.updateBackupVSCFinalizer(ctx, vscName, func(obj client.Object) bool {
+		return controllerutil.RemoveFinalizer(obj, BackupVSCExposeFinalizer)
+	})
+
+	if apierrors.IsNotFound(errors.Cause(err)) {
+		return nil
+	}
+
+	return err
+}
+
+func (e *csiSnapshotExposer) updateBackupVSCFinalizer(ctx context.Context, vscName string, update func(client.Object) bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		vsc, err := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, vscName, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "error to get backup VSC %s", vscName)
+		}
+
+		if !update(vsc) {
+			return nil
+		}
+
+		_, err = e.csiSnapshotClient.VolumeSnapshotContents().Update(ctx, vsc, metav1.UpdateOptions{})
+		return err
+	})
+}
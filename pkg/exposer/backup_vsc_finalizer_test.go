@@ -0,0 +1,232 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func TestBackupVSCFinalizer(t *testing.T) {
+	vscName := "fake-vsc"
+	snapshotClass := "fake-snapshot-class"
+	snapshotHandle := "fake-handle"
+	var restoreSize int64 = 123456
+
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &vscName,
+			},
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+			RestoreSize:                    resource.NewQuantity(restoreSize, ""),
+		},
+	}
+
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy:          snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:                  "fake-driver",
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			RestoreSize:    &restoreSize,
+			SnapshotHandle: &snapshotHandle,
+		},
+	}
+
+	vsClassObj := &snapshotv1api.VolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: snapshotClass,
+		},
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	param := &CSISnapshotExposeParam{
+		SnapshotName:     "fake-vs",
+		SourceNamespace:  "fake-ns",
+		AccessMode:       AccessModeFileSystem,
+		OperationTimeout: time.Millisecond,
+		ExposeTimeout:    time.Millisecond,
+	}
+
+	getBackupVSCFinalizer := func(t *testing.T, snapshotClient *snapshotFake.Clientset) bool {
+		t.Helper()
+
+		backupVSC, err := snapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		return controllerutil.ContainsFinalizer(backupVSC, BackupVSCExposeFinalizer)
+	}
+
+	t.Run("backup VSC is protected until backup PVC is bound", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset(daemonSet)
+		snapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj)
+
+		// simulate the race that the snapshot controller garbage-collects the backup VSC as soon as the source VS is deleted,
+		// which is only blocked by the finalizer
+		protected := false
+		snapshotClient.Fake.PrependReactor("delete", "volumesnapshots", func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+			if action.GetNamespace() == vsObject.Namespace {
+				obj, err := snapshotClient.Tracker().Get(snapshotv1api.SchemeGroupVersion.WithResource("volumesnapshotcontents"), "", ownerObject.Name)
+				require.NoError(t, err)
+
+				protected = controllerutil.ContainsFinalizer(obj.(*snapshotv1api.VolumeSnapshotContent), BackupVSCExposeFinalizer)
+			}
+			return false, nil, nil
+		})
+
+		exposer := NewCSISnapshotExposer(kubeClient, snapshotClient.SnapshotV1(), velerotest.NewLogger(), WithBackupVSCFinalizer())
+		require.NoError(t, exposer.Expose(context.Background(), ownerObject, param))
+
+		assert.True(t, protected)
+		assert.True(t, getBackupVSCFinalizer(t, snapshotClient))
+
+		backupPod, err := kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		backupPVC, err := kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		// the finalizer is kept while the backup PVC is not bound
+		_, err = exposer.GetExposed(context.Background(), ownerObject, 10*time.Millisecond, &CSISnapshotExposeWaitParam{
+			NodeClient: clientFake.NewClientBuilder().WithRuntimeObjects(backupPod).Build(),
+			NodeName:   "fake-node",
+		})
+		require.Error(t, err)
+		assert.True(t, getBackupVSCFinalizer(t, snapshotClient))
+
+		backupPVC.Spec.VolumeName = "fake-pv"
+		_, err = kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Update(context.Background(), backupPVC, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		_, err = kubeClient.CoreV1().PersistentVolumes().Create(context.Background(), &corev1api.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "fake-pv"}}, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		result, err := exposer.GetExposed(context.Background(), ownerObject, 10*time.Millisecond, &CSISnapshotExposeWaitParam{
+			NodeClient: clientFake.NewClientBuilder().WithRuntimeObjects(backupPod).Build(),
+			NodeName:   "fake-node",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.False(t, getBackupVSCFinalizer(t, snapshotClient))
+	})
+
+	t.Run("finalizer is removed when expose fails", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset(daemonSet)
+		kubeClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+			return true, nil, errors.New("fake-create-error")
+		})
+
+		snapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj)
+
+		exposer := NewCSISnapshotExposer(kubeClient, snapshotClient.SnapshotV1(), velerotest.NewLogger(), WithBackupVSCFinalizer())
+		require.Error(t, exposer.Expose(context.Background(), ownerObject, param))
+
+		assert.False(t, getBackupVSCFinalizer(t, snapshotClient))
+	})
+
+	t.Run("finalizer is not added by default", func(t *testing.T) {
+		snapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj)
+
+		exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotClient.SnapshotV1(), velerotest.NewLogger())
+		require.NoError(t, exposer.Expose(context.Background(), ownerObject, param))
+
+		assert.False(t, getBackupVSCFinalizer(t, snapshotClient))
+	})
+
+	t.Run("cleanup removes the left finalizer", func(t *testing.T) {
+		backupVSC := &snapshotv1api.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       ownerObject.Name,
+				Finalizers: []string{BackupVSCExposeFinalizer, "fake-finalizer"},
+			},
+		}
+
+		backupVS := &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ownerObject.Name,
+				Namespace: ownerObject.Namespace,
+			},
+		}
+
+		snapshotClient := snapshotFake.NewSimpleClientset(backupVS, backupVSC)
+
+		exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotClient.SnapshotV1(), velerotest.NewLogger(), WithCleanUpConcurrency(false))
+		exposer.CleanUp(context.Background(), ownerObject, "", "")
+
+		updated, err := snapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"fake-finalizer"}, updated.Finalizers)
+	})
+}
@@ -0,0 +1,65 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+	"k8s.io/utils/clock"
+)
+
+// cleanUpRateLimiter bounds the rate of the delete calls issued by CleanUp, so that cleaning up a large number of exposes,
+// e.g., when deleting a big backup, doesn't spike the load of the API server
+type cleanUpRateLimiter struct {
+	limiter *rate.Limiter
+	clock   clock.Clock
+}
+
+func newCleanUpRateLimiter(qps float64, burst int) *cleanUpRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &cleanUpRateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(qps), burst),
+		clock:   clock.RealClock{},
+	}
+}
+
+// wait blocks until a delete call is allowed or the context is done, a nil limiter never blocks
+func (l *cleanUpRateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	now := l.clock.Now()
+	reservation := l.limiter.ReserveN(now, 1)
+
+	delay := reservation.DelayFrom(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-l.clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		reservation.CancelAt(l.clock.Now())
+		return ctx.Err()
+	}
+}
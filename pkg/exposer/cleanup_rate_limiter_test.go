@@ -0,0 +1,132 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	testclocks "k8s.io/utils/clock/testing"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// stepUntilDone steps the fake clock each time the limiter is waiting until done is closed, and returns the steps taken
+func stepUntilDone(fakeClock *testclocks.FakeClock, step time.Duration, done chan struct{}) int {
+	steps := 0
+	for {
+		select {
+		case <-done:
+			return steps
+		default:
+		}
+
+		if fakeClock.HasWaiters() {
+			fakeClock.Step(step)
+			steps++
+		} else {
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+func TestCleanUpRateLimiter(t *testing.T) {
+	var limiter *cleanUpRateLimiter
+	require.NoError(t, limiter.wait(context.Background()))
+
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	limiter = newCleanUpRateLimiter(1, 2)
+	limiter.clock = fakeClock
+
+	// the burst is allowed without waiting
+	require.NoError(t, limiter.wait(context.Background()))
+	require.NoError(t, limiter.wait(context.Background()))
+
+	done := make(chan struct{})
+	var err error
+	go func() {
+		defer close(done)
+		err = limiter.wait(context.Background())
+	}()
+
+	assert.Equal(t, 1, stepUntilDone(fakeClock, time.Second, done))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, limiter.wait(ctx), context.Canceled)
+}
+
+func TestCleanUpRateLimited(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: "velero",
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	tests := []struct {
+		name          string
+		qps           float64
+		burst         int
+		expectedSteps int
+	}{
+		{
+			name:          "not limited",
+			expectedSteps: 0,
+		},
+		{
+			name:          "one delete per second",
+			qps:           1,
+			burst:         1,
+			expectedSteps: 3,
+		},
+		{
+			name:          "burst of two",
+			qps:           1,
+			burst:         2,
+			expectedSteps: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClock := testclocks.NewFakeClock(time.Now())
+
+			exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(),
+				WithCleanUpConcurrency(false), WithCleanUpRateLimit(test.qps, test.burst)).(*csiSnapshotExposer)
+			if exposer.cleanUpRateLimiter != nil {
+				exposer.cleanUpRateLimiter.clock = fakeClock
+			}
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				exposer.CleanUp(context.Background(), ownerObject, "fake-vs", "fake-ns")
+			}()
+
+			assert.Equal(t, test.expectedSteps, stepUntilDone(fakeClock, time.Second, done))
+		})
+	}
+}
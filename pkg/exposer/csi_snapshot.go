@@ -18,24 +18,49 @@ package exposer
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"net"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	snapshotter "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/typed/volumesnapshot/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	corev1api "k8s.io/api/core/v1"
+	storagev1api "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/csi"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -47,6 +72,12 @@ type CSISnapshotExposeParam struct {
 	// SourceNamespace is the original namespace of the volume that the snapshot is taken for
 	SourceNamespace string
 
+	// TargetNamespace specifies the namespace of the backup VS, PVC and pod, e.g., a dedicated namespace isolating the data movers of tenants.
+	// The objects in a namespace other than the owner's have no owner reference, since it can't cross namespaces, and are labeled with the owner UID instead.
+	// The service account, secrets and config maps inherited from node-agent must exist in the target namespace too.
+	// If it is empty, the objects are created in the namespace of the owner
+	TargetNamespace string
+
 	// AccessMode defines the mode to access the snapshot
 	AccessMode string
 
@@ -68,17 +99,240 @@ type CSISnapshotExposeParam struct {
 	// VolumeSize specifies the size of the source volume
 	VolumeSize resource.Quantity
 
-	// Affinity specifies the node affinity of the backup pod
-	Affinity *kube.LoadAffinity
+	// Affinities specifies the node affinities of the backup pod, the backup pod could be scheduled to nodes matching any of them
+	Affinities []*kube.LoadAffinity
 
 	// BackupPVCConfig is the config for backupPVC (intermediate PVC) of snapshot data movement
 	BackupPVCConfig map[string]nodeagent.BackupPVC
 
-	// Resources defines the resource requirements of the hosting pod
+	// Resources defines the resource requirements of the hosting pod.
+	// For file system access mode, the data mover may spill temp files to the pod's ephemeral storage, so it is recommended
+	// to request ephemeral-storage, e.g., 1Gi, to avoid the eviction for exceeding the node's disk. The request must not exceed the limit.
+	// If it is empty, the requirements are recommended by the volume size, see RecommendResources
 	Resources corev1api.ResourceRequirements
 
-	// NodeOS specifies the OS of node that the source volume is attaching
+	// NodeOS specifies the OS of node that the source volume is attaching.
+	// If it is empty, it is detected from the node hosting the source volume
 	NodeOS string
+
+	// NodeOSLabelKeys specifies the node label keys used to select nodes of NodeOS for the backup pod.
+	// If it is empty, kube.NodeOSLabel is used
+	NodeOSLabelKeys []string
+
+	// WindowsTolerations specifies the tolerations appended to the default os=windows:NoSchedule toleration of the backup pod for Windows nodes,
+	// e.g., for the cluster-specific taints of the Windows version. They don't apply to the backup pod for Linux nodes
+	WindowsTolerations []corev1api.Toleration
+
+	// SkipDefaultWindowsToleration specifies whether to drop the default os=windows:NoSchedule toleration of the backup pod for Windows nodes,
+	// e.g., for the clusters not tainting Windows nodes. WindowsTolerations still apply
+	SkipDefaultWindowsToleration bool
+
+	// RunAsNonRoot specifies whether the backup pod runs as a non-root user for Linux nodes, e.g., for namespaces enforcing the restricted pod security standard.
+	// By default, the backup pod runs as root, which is required by SELinux relabeling, so it is not compatible with spcNoRelabeling
+	RunAsNonRoot bool
+
+	// RunAsUserID specifies the user ID the backup pod runs as when RunAsNonRoot is set
+	RunAsUserID *int64
+
+	// AdoptExistingBackupPod specifies whether to adopt the backup pod if it already exists and is controlled by another owner.
+	// The existing backup pod is always adopted if it is owned by the same owner and mounts the same backup PVC, otherwise, it is recreated
+	AdoptExistingBackupPod bool
+
+	// BackupVolumeSnapshotClass specifies the VolumeSnapshotClass of the backup VS and VSC, e.g., when the class of the source snapshot doesn't exist in the cluster.
+	// If it is empty, the class is copied from the source snapshot
+	BackupVolumeSnapshotClass string
+
+	// DefaultVolumeSnapshotClass specifies the VolumeSnapshotClass of the backup VS and VSC when the class of the source snapshot has been deleted.
+	// If it is empty, Expose fails fast in that case, instead of creating the backup VS and VSC that are never reconciled
+	DefaultVolumeSnapshotClass string
+
+	// StorageClassMaxSizeKey specifies the key of the annotation or parameter in the backupPVC's storage class that caps the volume size.
+	// If it is set and the storage class has the key, Expose fails when the size of the backupPVC exceeds the cap.
+	// If it is empty, the size is not checked
+	StorageClassMaxSizeKey string
+
+	// PreserveSnapshotLabels specifies whether the labels of the source snapshot are copied to the backup VS and VSC, e.g., for cost allocation.
+	// The reserved Velero label keys are never copied. If it is nil, the labels are preserved
+	PreserveSnapshotLabels *bool
+
+	// PVCCreateMaxAttempts specifies the max attempts to create the backupPVC when the API server returns transient errors,
+	// i.e., server timeout, too many requests or conflict. Zero or one means a single attempt
+	PVCCreateMaxAttempts int
+
+	// PVCCreateRetryBaseDelay specifies the delay before the first retry of creating the backupPVC, the delay is doubled for each retry.
+	// If it is zero, defaultPVCCreateRetryBaseDelay is used
+	PVCCreateRetryBaseDelay time.Duration
+
+	// InjectSnapshotMetadataEnv specifies whether to inject the snapshot metadata into the backup container as env vars,
+	// i.e., VELERO_SNAPSHOT_DRIVER, VELERO_SNAPSHOT_CLASS, VELERO_RESTORE_SIZE and VELERO_SOURCE_NAMESPACE,
+	// so that the data mover doesn't need to query them from the API server
+	InjectSnapshotMetadataEnv bool
+
+	// VerifyBackupVSBinding specifies whether to wait the backup VS bound to the backup VSC before creating the backupPVC,
+	// so that a wrong binding is detected early instead of failing the backupPVC provisioning. The wait is bounded by OperationTimeout
+	VerifyBackupVSBinding bool
+
+	// RebuildOnSourceSnapshotChange specifies whether to rebuild the backup VS and VSC left by a previous expose attempt,
+	// if the source snapshot has been re-created since then so that the backup VSC refers to a stale snapshot handle.
+	// If it is not set, Expose fails in this case
+	RebuildOnSourceSnapshotChange bool
+
+	// StaticBackupPVName specifies the name of the backup PV, which is statically created from the snapshot handle of the backup VSC
+	// and pre-bound to the backupPVC, bypassing the dynamic provisioning, e.g., for operators reconciling PVs by name.
+	// It only works with the CSI drivers that are able to publish the snapshot handle as a volume.
+	// If it is empty, the backup PV is dynamically provisioned from the backup VS
+	StaticBackupPVName string
+
+	// SourcePVCSelector specifies the selector of the source PVC mirrored onto the backupPVC, e.g., for the drivers binding volumes by labels.
+	// The backupPVC has no data source with a selector, so it requires StaticBackupPVName, which is labeled with the match labels of the selector,
+	// and the storage class of the backupPVC must bind volumes immediately. If it is nil, the backupPVC has no selector
+	SourcePVCSelector *metav1.LabelSelector
+
+	// PreserveSourcePVCAnnotations specifies the annotation keys of the source PVC copied to the backupPVC, e.g., for the drivers
+	// reading provisioning hints from the PVC annotations. The keys not found in the source PVC, the Velero reserved keys and the keys
+	// managed by the PV controller for binding are skipped. It only works with Expose, where the source PVC is known from the source snapshot.
+	// If it is empty, no annotation is copied
+	PreserveSourcePVCAnnotations []string
+
+	// SkipSourceSnapshotRetain specifies whether to leave the source snapshot untouched, e.g., for the statically provisioned source content.
+	// When it is set, the source VSC is not patched to Retain and the source VS and VSC are not deleted by Expose; instead, the backup VSC
+	// is created with the Retain deletion policy, so that deleting the backup VS doesn't delete the snapshot still referred by the source VSC.
+	// CleanUp deletes the source VS by the name passed to it, so pass an empty name to keep the source VS
+	SkipSourceSnapshotRetain bool
+
+	// MaxExposePodsPerNode specifies the max number of the expose pods, which attach the expose PVCs, a node could host.
+	// The backup pod is not scheduled to the nodes already hosting this number of expose pods. Zero means no limit
+	MaxExposePodsPerNode int
+
+	// TopologySpread specifies the topology spread constraints of the backup pod, e.g., to spread backup pods across zones.
+	// Each constraint must select the backup pods by the exposer pod group label, so that the backup pods are spread as a group.
+	// If it is nil, the backup pods are spread across nodes in best effort
+	TopologySpread []corev1api.TopologySpreadConstraint
+
+	// NodeAgentAffinity specifies how the backup pod is co-located with the node-agent pods, by the pod affinity to them.
+	// If it is empty, NodeAgentAffinityOff is used
+	NodeAgentAffinity NodeAgentAffinityMode
+
+	// AvoidNodesAtAttachLimit specifies whether to exclude the nodes at the attach limit of the CSI driver reported by CSINode,
+	// so that the backup pod is not scheduled to a node where the backupPVC could never be attached
+	AvoidNodesAtAttachLimit bool
+
+	// PodActiveDeadline specifies the duration the backup pod may be active before kubelet fails it, e.g., when the data mover hangs on a wedged mount.
+	// Zero means no deadline
+	PodActiveDeadline time.Duration
+
+	// PodRestartPolicy specifies the restart policy of the backup pod, only Never and OnFailure are supported. If it is empty, Never is used.
+	// With OnFailure, kubelet restarts the crashed data mover container in place, e.g., after a transient mount failure, so the pod doesn't
+	// turn to Failed and PeekExposed doesn't report it as unrecoverable, unless the restarts exceed PodRestartLimit
+	PodRestartPolicy corev1api.RestartPolicy
+
+	// PodRestartLimit specifies the max restarts of the data mover container with the OnFailure restart policy,
+	// after which PeekExposed reports the backup pod as unrecoverable. Zero means no limit
+	PodRestartLimit int32
+
+	// PodTerminationGracePeriod specifies the termination grace period in seconds of the backup pod. If it is nil, zero is used,
+	// with which the backup pod is killed immediately to tear down fast. That may leave the CSI mounts half-torn-down for the
+	// drivers with slow unmount paths, for which a grace period gives the chance to unmount cleanly, at the cost of a slower CleanUp
+	PodTerminationGracePeriod *int64
+
+	// NameSource overrides the owner UID as the name of the backup container and the backup volume in the backup pod,
+	// e.g., for the owners without UID in synthetic flows. It must be a valid DNS label.
+	// If it is empty, the owner UID is used, and Expose fails if the owner UID is empty too
+	NameSource string
+
+	// ExtraVolumes specifies the volumes added to the backup pod after the backupPVC and the volumes inherited from the node-agent pod,
+	// e.g., a host directory with the credentials required by the CSI driver
+	ExtraVolumes []corev1api.Volume
+
+	// ExtraVolumeMounts specifies the volume mounts added to the backup container after the backupPVC and the inherited ones.
+	// The mount paths must not collide with the path of the backupPVC, otherwise Expose fails
+	ExtraVolumeMounts []corev1api.VolumeMount
+
+	// DNSPolicy overrides the DNS policy inherited from the node-agent pod, e.g., when the backup pod needs custom nameservers
+	// to resolve the object store endpoint. If it is nil, the DNS policy of the node-agent pod is used
+	DNSPolicy *corev1api.DNSPolicy
+
+	// DNSConfig overrides the DNS config inherited from the node-agent pod. If it is nil, the DNS config of the node-agent pod is used
+	DNSConfig *corev1api.PodDNSConfig
+
+	// HostAliases specifies the entries added to the hosts file of the backup pod, e.g., to resolve the object store endpoint in the air-gapped environments.
+	// If it is empty, the hosts file is left unchanged
+	HostAliases []corev1api.HostAlias
+
+	// VolumeSizeMismatchTolerance specifies the percentage of VolumeSize by which the restore size of the snapshot may differ from VolumeSize,
+	// a larger discrepancy usually indicates a driver bug or a thin-provisioned snapshot. Zero disables the check.
+	// When it is enabled, the larger one of the two sizes is used for the backup PVC
+	VolumeSizeMismatchTolerance int
+
+	// FailOnVolumeSizeMismatch specifies whether to fail the expose when the discrepancy exceeds VolumeSizeMismatchTolerance, otherwise, a warning is logged
+	FailOnVolumeSizeMismatch bool
+
+	// VolumeSizeOverhead specifies the size added to the backupPVC on top of the detected volume size, e.g., as the scratch space of the data mover.
+	// The restore size injected by InjectSnapshotMetadataEnv doesn't include it. Zero means no overhead
+	VolumeSizeOverhead resource.Quantity
+
+	// NodeName specifies the node to run the backup pod, e.g., to debug the CSI driver of a specific node. When it is set, the backup pod is bound
+	// to the node directly, bypassing the affinity and topology spread. The node must exist and match the node OS. If it is empty, the scheduler places the pod
+	NodeName string
+
+	// ImagePullPolicy specifies the image pull policy of the backup container, e.g., IfNotPresent for the nodes where the node-agent image is not pre-pulled.
+	// If it is empty, PullNever is used, which assumes the image is present on every node running node-agent
+	ImagePullPolicy corev1api.PullPolicy
+
+	// SchedulerName specifies the scheduler to schedule the backup pod, e.g., a batch scheduler. If it is empty, the default scheduler is used
+	SchedulerName string
+
+	// ReuseBackupPVC specifies whether Expose reuses the bound backupPVC left by a previous expose of the same owner, e.g., on the retry after the backup pod fails,
+	// so that only the backup pod is (re)created instead of provisioning the volume from the snapshot again. A failed or succeeded backup pod left by the
+	// previous expose is deleted after the expose param is validated. The backupPVC is only reused if its data source is the backup VS of the owner, otherwise, the snapshot is exposed as usual
+	ReuseBackupPVC bool
+
+	// Direction specifies whether the data mover in the backup pod runs a backup or a restore, i.e., the data-mover subcommand and
+	// whether the owner is passed as --data-upload or --data-download. It is ignored by the command builder of WithBackupPodCommandBuilder.
+	// If it is empty, ExposeDirectionBackup is used
+	Direction ExposeDirection
+
+	// AutomountServiceAccountToken specifies whether the token of the service account inherited from node-agent is mounted into the backup pod,
+	// e.g., set it to false for security baselines forbidding the token in pods that don't call the API server. If it is nil, the default of the service account applies
+	AutomountServiceAccountToken *bool
+
+	// RuntimeClassName specifies the RuntimeClass of the backup pod, e.g., a sandboxed runtime isolating the tenant data. If it is nil, the default runtime of the node is used
+	RuntimeClassName *string
+
+	// SeccompProfile specifies the seccomp profile of the backup pod and container, e.g., RuntimeDefault for the hardened clusters.
+	// It is applied along with the SELinux options of spcNoRelabeling and is ignored for Windows, which doesn't support seccomp. If it is nil, it is left unset
+	SeccompProfile *corev1api.SeccompProfile
+
+	// SELinuxOptions specifies the SELinux options of the backup pod, e.g., the MCS level "s0:c123,c456" in the environments enforcing multi-category security.
+	// For the read-only backupPVC with spcNoRelabeling, the volume is not relabeled, so the type must be allowed to access the volume with its original label,
+	// and spc_t is used if Type is empty. Otherwise, the volume is relabeled with the level by the container runtime. It is ignored for Windows.
+	// If it is nil, only spc_t is set for spcNoRelabeling
+	SELinuxOptions *corev1api.SELinuxOptions
+
+	// FSGroup specifies the fsGroup of the backup pod, so that the data mover is granted the access to the filesystem backupPVC through the group ownership
+	// on the drivers not supporting SELinux relabeling. It takes no effect for the block backupPVC and is ignored for Windows. If it is nil, it is left unset
+	FSGroup *int64
+
+	// FSGroupChangePolicy specifies how the ownership and permission of the backupPVC are changed to FSGroup, e.g., OnRootMismatch to skip the expensive
+	// recursive change on huge volumes whose root already matches. It requires FSGroup. If it is nil, the default Always is applied by Kubernetes
+	FSGroupChangePolicy *corev1api.PodFSGroupChangePolicy
+
+	// MountWorkDir specifies whether to mount a working directory on the node scoped to the owner into the backup container at WorkDirMountPath,
+	// e.g., as the node-local staging directory of the data mover. It requires the exposer created with WithHostPathWorkDir
+	MountWorkDir bool
+
+	// DryRun specifies whether to only validate the expose without creating or changing any object.
+	// When it is set, Expose resolves the plan as PlanExpose does and returns the validation error if any
+	DryRun bool
+
+	// AllowSidecarInjection specifies whether service mesh sidecars are allowed to be injected to the backup pod.
+	// By default, the backup pod is annotated to opt out of the sidecar injection, since the sidecar may intercept the data mover's traffic
+	AllowSidecarInjection bool
+
+	// ReadinessProbe specifies the readiness probe of the data mover container, e.g., an exec probe checking a file the mover creates once its mount is initialized.
+	// With it, GetExposed waits the backup pod ready before returning the expose result.
+	// If it is nil, the container has no probe, e.g., for the movers without a probe endpoint, and GetExposed doesn't wait the readiness
+	ReadinessProbe *corev1api.Probe
 }
 
 // CSISnapshotExposeWaitParam define the input param for WaitExposed of CSI snapshots
@@ -86,339 +340,3047 @@ type CSISnapshotExposeWaitParam struct {
 	// NodeClient is the client that is used to find the hosting pod
 	NodeClient client.Client
 	NodeName   string
+
+	// ForcePVReclaimDelete specifies whether to set the reclaim policy of the backup PV to Delete once the backup PVC is bound,
+	// so that the backup PV is always removed with the backup PVC regardless of the reclaim policy of the storage class.
+	// The original reclaim policy is recorded in the backup PV's annotation
+	ForcePVReclaimDelete bool
+
+	// NameSource must be the same as CSISnapshotExposeParam.NameSource of the expose
+	NameSource string
 }
 
-// NewCSISnapshotExposer create a new instance of CSI snapshot exposer
-func NewCSISnapshotExposer(kubeClient kubernetes.Interface, csiSnapshotClient snapshotter.SnapshotV1Interface, log logrus.FieldLogger) SnapshotExposer {
-	return &csiSnapshotExposer{
-		kubeClient:        kubeClient,
-		csiSnapshotClient: csiSnapshotClient,
-		log:               log,
+// CSISnapshotExposerOption customizes the CSI snapshot exposer created by NewCSISnapshotExposer
+type CSISnapshotExposerOption func(*csiSnapshotExposer)
+
+// WithCleanUpConcurrency specifies whether CleanUp deletes the independent objects concurrently, by default, it is disabled
+// and the objects are deleted one by one
+func WithCleanUpConcurrency(concurrent bool) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.cleanUpConcurrently = concurrent
 	}
 }
 
-type csiSnapshotExposer struct {
-	kubeClient        kubernetes.Interface
-	csiSnapshotClient snapshotter.SnapshotV1Interface
-	log               logrus.FieldLogger
+// WithEventRecorder specifies the recorder to record events to the owner object at the key milestones of the expose
+func WithEventRecorder(recorder record.EventRecorder) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.eventRecorder = recorder
+	}
 }
 
-func (e *csiSnapshotExposer) Expose(ctx context.Context, ownerObject corev1api.ObjectReference, param any) error {
-	csiExposeParam := param.(*CSISnapshotExposeParam)
+// WithBackupPVCConfigMap specifies the ConfigMap to load the default backupPVC config from, the ConfigMap has one data item
+// in JSON or YAML, mapping the source storage class to the backupPVC config.
+// The loaded config is cached for a short period and merged with CSISnapshotExposeParam.BackupPVCConfig, the latter wins
+func WithBackupPVCConfigMap(namespace string, name string) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.backupPVCConfigLoader = newBackupPVCConfigLoader(namespace, name, defaultBackupPVCConfigCacheTTL)
+	}
+}
 
-	curLog := e.log.WithFields(logrus.Fields{
-		"owner": ownerObject.Name,
-	})
+// WithDiagnosePodLogs specifies DiagnoseExpose to include the last tailLines lines of the backup container's logs.
+// If tailLines is not positive, defaultDiagnosePodLogLines is used
+func WithDiagnosePodLogs(tailLines int64) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		if tailLines <= 0 {
+			tailLines = defaultDiagnosePodLogLines
+		}
 
-	curLog.Info("Exposing CSI snapshot")
+		e.diagnosePodLogLines = tailLines
+	}
+}
 
-	volumeSnapshot, err := csi.WaitVolumeSnapshotReady(ctx, e.csiSnapshotClient, csiExposeParam.SnapshotName, csiExposeParam.SourceNamespace, csiExposeParam.ExposeTimeout, curLog)
-	if err != nil {
-		return errors.Wrapf(err, "error wait volume snapshot ready")
+// WithOwnerFinalizer specifies the client to patch the owner object, with which Expose adds ExposeCleanUpFinalizer to
+// the owner and CleanUp removes it after the objects generated by the expose are deleted
+func WithOwnerFinalizer(ownerClient client.Client) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.ownerClient = ownerClient
 	}
+}
 
-	curLog.Info("Volumesnapshot is ready")
+// WithNodeNotReadyGrace specifies the grace period that the node of the backup pod could be NotReady,
+// after which PeekExposed returns ErrNodeNotReady. By default, the node's readiness is not checked
+func WithNodeNotReadyGrace(grace time.Duration) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.nodeNotReadyGrace = grace
+	}
+}
 
-	vsc, err := csi.GetVolumeSnapshotContentForVolumeSnapshot(volumeSnapshot, e.csiSnapshotClient)
-	if err != nil {
-		return errors.Wrap(err, "error to get volume snapshot content")
+// WithSnapshotControllerPreflight specifies the deployment of the CSI snapshot controller, with which Expose fails fast
+// if the snapshot controller is not running, e.g., scaled down for maintenance, instead of waiting the snapshot ready until timeout
+func WithSnapshotControllerPreflight(namespace string, name string) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.snapshotController = &types.NamespacedName{Namespace: namespace, Name: name}
 	}
+}
 
-	curLog.WithField("vsc name", vsc.Name).WithField("vs name", volumeSnapshot.Name).Infof("Got VSC from VS in namespace %s", volumeSnapshot.Namespace)
+// WithCleanUpRateLimit specifies the max rate of the delete calls issued by CleanUp, i.e., qps with a burst of burst,
+// so that mass cleanup doesn't spike the load of the API server. By default, the rate is not limited
+func WithCleanUpRateLimit(qps float64, burst int) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		if qps > 0 {
+			e.cleanUpRateLimiter = newCleanUpRateLimiter(qps, burst)
+		}
+	}
+}
 
-	backupVS, err := e.createBackupVS(ctx, ownerObject, volumeSnapshot)
-	if err != nil {
-		return errors.Wrap(err, "error to create backup volume snapshot")
+// WithOwnerConditions specifies the client to patch the status of the owner object, with which the expose condition
+// (ExposeConditionType) is maintained in the owner's status.conditions as Expose and GetExposed progress.
+// The owner must have a status subresource with the standard conditions
+func WithOwnerConditions(ownerClient client.Client) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.conditionClient = ownerClient
 	}
+}
 
-	curLog.WithField("vs name", backupVS.Name).Infof("Backup VS is created from %s/%s", volumeSnapshot.Namespace, volumeSnapshot.Name)
+// WithSnapshotReadyInformer specifies Expose to detect the readiness of the snapshot by a VolumeSnapshot informer shared by all the exposes,
+// instead of polling each snapshot. The informer is started on the first expose and stopped when ctx is done, after which the readiness is polled
+func WithSnapshotReadyInformer(ctx context.Context) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.snapshotReadyWatcher = newSnapshotReadyWatcher(ctx, e.csiSnapshotClient)
+	}
+}
 
-	defer func() {
-		if err != nil {
-			csi.DeleteVolumeSnapshotIfAny(ctx, e.csiSnapshotClient, backupVS.Name, backupVS.Namespace, curLog)
+// WithExposeResultCache specifies GetExposed to cache its result for ttl, so that the repeated calls return the cached result
+// without reading the backupPVC and PV again. The cached result is invalidated once the backup pod changes, i.e., in a new resourceVersion
+func WithExposeResultCache(ttl time.Duration) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		if ttl > 0 {
+			e.exposeResultCache = newExposeResultCache(ttl)
 		}
-	}()
+	}
+}
 
-	backupVSC, err := e.createBackupVSC(ctx, ownerObject, vsc, backupVS)
-	if err != nil {
-		return errors.Wrap(err, "error to create backup volume snapshot content")
+// WithPodDisruptionBudget enables a PodDisruptionBudget per expose that blocks the voluntary disruption of the backup pod,
+// e.g., node drains, until CleanUp. It requires PodActiveDeadline, which caps the duration of the protection
+func WithPodDisruptionBudget() CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.podDisruptionBudget = true
 	}
+}
 
-	curLog.WithField("vsc name", backupVSC.Name).Infof("Backup VSC is created from %s", vsc.Name)
+// WithDiagnoseRedaction redacts the values of the keys matching any of the patterns from the diagnostic info, in addition to
+// the well-known sensitive keys, e.g., password and token, which are always redacted. The patterns are case insensitive regular expressions
+func WithDiagnoseRedaction(keyPatterns ...string) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.diagnoseRedactor = newDiagnoseRedactor(keyPatterns, e.log)
+	}
+}
 
-	retained, err := csi.RetainVSC(ctx, e.csiSnapshotClient, vsc)
-	if err != nil {
-		return errors.Wrap(err, "error to retain volume snapshot content")
+// WithHostPathWorkDir allows the expose to mount a per-owner working directory under basePath on the node into the backup container
+// by CSISnapshotExposeParam.MountWorkDir, which is rejected without this option given the security sensitivity of hostPath volumes.
+// CleanUp removes the working directory of the owner through fs, so basePath must be mounted at the same path where CleanUp runs
+func WithHostPathWorkDir(basePath string, fs filesystem.Interface) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.workDirBasePath = basePath
+		e.workDirFS = fs
 	}
+}
 
-	curLog.WithField("vsc name", vsc.Name).WithField("retained", (retained != nil)).Info("Finished to retain VSC")
+// WithBackupPodCommandBuilder overrides the command and args of the backup container, e.g., to run an alternative data mover binary.
+// By default, DefaultBackupPodCommandBuilder is used
+func WithBackupPodCommandBuilder(builder BackupPodCommandBuilder) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.backupPodCommandBuilder = builder
+	}
+}
 
-	err = csi.EnsureDeleteVS(ctx, e.csiSnapshotClient, volumeSnapshot.Name, volumeSnapshot.Namespace, csiExposeParam.OperationTimeout)
-	if err != nil {
-		return errors.Wrap(err, "error to delete volume snapshot")
+// WithBackupPodResourceRecommender overrides the heuristic estimating the resource requirements of the backup pod when the caller
+// passes an empty Resources. By default, DefaultBackupPodResourceRecommender is used
+func WithBackupPodResourceRecommender(recommender BackupPodResourceRecommender) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.backupPodResourceRecommender = recommender
 	}
+}
 
-	curLog.WithField("vs name", volumeSnapshot.Name).Infof("VS is deleted in namespace %s", volumeSnapshot.Namespace)
+// WithBackupVSCFinalizer enables adding BackupVSCExposeFinalizer to the backup VSC created by Expose before the source snapshot is deleted,
+// so that the retained snapshot handle can't be garbage-collected until the backupPVC is bound. The finalizer is removed by GetExposed
+// once the backupPVC is bound, or by CleanUp
+func WithBackupVSCFinalizer() CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.backupVSCFinalizer = true
+	}
+}
 
-	err = csi.EnsureDeleteVSC(ctx, e.csiSnapshotClient, vsc.Name, csiExposeParam.OperationTimeout)
-	if err != nil {
-		return errors.Wrap(err, "error to delete volume snapshot content")
+// WithMaxConcurrentExpose specifies the max number of the exposes creating the backup VS, VSC, PVC and pod concurrently,
+// the other exposes wait for a slot before creating any object, so that the create rate to the API server is smoothed.
+// Waiting for the source snapshot to be ready doesn't take a slot. By default, the concurrency is not limited
+func WithMaxConcurrentExpose(maxConcurrent int) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		if maxConcurrent > 0 {
+			e.exposeGate = newExposeGate(maxConcurrent)
+		}
 	}
+}
 
-	curLog.WithField("vsc name", vsc.Name).Infof("VSC is deleted")
+// WithCleanUpTimeout specifies how long CleanUp waits for the backup PV and PVC to be removed, e.g., a longer timeout for the slow storage
+// where the deletion of the PV takes several minutes. If timeout is not positive, defaultCleanUpTimeout is used
+func WithCleanUpTimeout(timeout time.Duration) CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		if timeout <= 0 {
+			timeout = defaultCleanUpTimeout
+		}
 
-	var volumeSize resource.Quantity
-	if volumeSnapshot.Status.RestoreSize != nil && !volumeSnapshot.Status.RestoreSize.IsZero() {
-		volumeSize = *volumeSnapshot.Status.RestoreSize
-	} else {
-		volumeSize = csiExposeParam.VolumeSize
-		curLog.WithField("vs name", volumeSnapshot.Name).Warnf("The snapshot doesn't contain a valid restore size, use source volume's size %v", volumeSize)
+		e.cleanUpTimeout = timeout
 	}
+}
 
-	// check if there is a mapping for source pvc storage class in backupPVC config
-	// if the mapping exists then use the values(storage class, readOnly accessMode)
-	// for backupPVC (intermediate PVC in snapshot data movement) object creation
-	backupPVCStorageClass := csiExposeParam.StorageClass
-	backupPVCReadOnly := false
-	spcNoRelabeling := false
-	if value, exists := csiExposeParam.BackupPVCConfig[csiExposeParam.StorageClass]; exists {
-		if value.StorageClass != "" {
-			backupPVCStorageClass = value.StorageClass
-		}
+// WithCleanUpWaitPodDeletion specifies CleanUp to wait for the backup pod to disappear before returning, for up to the cleanup timeout,
+// so that an immediate re-expose of the same owner doesn't collide with the terminating pod. By default, CleanUp doesn't wait
+func WithCleanUpWaitPodDeletion() CSISnapshotExposerOption {
+	return func(e *csiSnapshotExposer) {
+		e.cleanUpWaitPodDeletion = true
+	}
+}
 
-		backupPVCReadOnly = value.ReadOnly
-		if value.SPCNoRelabeling {
-			if backupPVCReadOnly {
-				spcNoRelabeling = true
-			} else {
-				curLog.WithField("vs name", volumeSnapshot.Name).Warn("Ignoring spcNoRelabling for read-write volume")
-			}
-		}
+// NewCSISnapshotExposer create a new instance of CSI snapshot exposer
+func NewCSISnapshotExposer(kubeClient kubernetes.Interface, csiSnapshotClient snapshotter.SnapshotV1Interface, log logrus.FieldLogger, opts ...CSISnapshotExposerOption) SnapshotExposer {
+	e := &csiSnapshotExposer{
+		kubeClient:        kubeClient,
+		csiSnapshotClient: csiSnapshotClient,
+		log:               log,
+		cleanUpTimeout:    defaultCleanUpTimeout,
 	}
 
-	backupPVC, err := e.createBackupPVC(ctx, ownerObject, backupVS.Name, backupPVCStorageClass, csiExposeParam.AccessMode, volumeSize, backupPVCReadOnly)
-	if err != nil {
-		return errors.Wrap(err, "error to create backup pvc")
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	if e.diagnoseRedactor == nil {
+		e.diagnoseRedactor = newDiagnoseRedactor(nil, log)
+	}
+
+	return e
+}
+
+type csiSnapshotExposer struct {
+	kubeClient                   kubernetes.Interface
+	csiSnapshotClient            snapshotter.SnapshotV1Interface
+	log                          logrus.FieldLogger
+	cleanUpConcurrently          bool
+	eventRecorder                record.EventRecorder
+	backupPVCConfigLoader        *backupPVCConfigLoader
+	diagnosePodLogLines          int64
+	ownerClient                  client.Client
+	nodeNotReadyGrace            time.Duration
+	snapshotController           *types.NamespacedName
+	cleanUpRateLimiter           *cleanUpRateLimiter
+	conditionClient              client.Client
+	snapshotReadyWatcher         *snapshotReadyWatcher
+	exposeResultCache            *exposeResultCache
+	podDisruptionBudget          bool
+	diagnoseRedactor             *diagnoseRedactor
+	workDirBasePath              string
+	workDirFS                    filesystem.Interface
+	backupPodCommandBuilder      BackupPodCommandBuilder
+	backupPodResourceRecommender BackupPodResourceRecommender
+	backupVSCFinalizer           bool
+	exposeGate                   *exposeGate
+	exposeNamespaces             sync.Map
+	cleanUpTimeout               time.Duration
+	cleanUpWaitPodDeletion       bool
+	vacSupportedLock             sync.Mutex
+	vacSupported                 *bool
+}
+
+func (e *csiSnapshotExposer) recordEvent(ownerObject corev1api.ObjectReference, warning bool, reason string, message string, args ...any) {
+	if e.eventRecorder == nil {
+		return
+	}
+
+	eventType := corev1api.EventTypeNormal
+	if warning {
+		eventType = corev1api.EventTypeWarning
+	}
+
+	e.eventRecorder.Eventf(&ownerObject, eventType, reason, message, args...)
+}
+
+// setOwnerCondition sets the expose condition of the owner object in best effort, a failure is logged but doesn't fail the expose
+func (e *csiSnapshotExposer) setOwnerCondition(ctx context.Context, ownerObject corev1api.ObjectReference, reason string, message string, args ...any) {
+	if e.conditionClient == nil {
+		return
+	}
+
+	if err := setExposeCondition(ctx, e.conditionClient, ownerObject, reason, fmt.Sprintf(message, args...)); err != nil {
+		e.log.WithField("owner", ownerObject.Name).WithError(err).Warnf("Failed to set expose condition %s", reason)
 	}
+}
+
+func (e *csiSnapshotExposer) Expose(ctx context.Context, ownerObject corev1api.ObjectReference, param any) (err error) {
+	csiExposeParam := param.(*CSISnapshotExposeParam)
+
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner": ownerObject.Name,
+	})
+
+	ctx, span := startSpan(ctx, "CSISnapshotExposer.Expose", ownerObject,
+		attribute.String(traceAttrSnapshot, csiExposeParam.SourceNamespace+"/"+csiExposeParam.SnapshotName))
+	defer func() {
+		endSpan(span, err)
+	}()
 
-	curLog.WithField("pvc name", backupPVC.Name).Info("Backup PVC is created")
 	defer func() {
 		if err != nil {
-			kube.DeletePVAndPVCIfAny(ctx, e.kubeClient.CoreV1(), backupPVC.Name, backupPVC.Namespace, 0, curLog)
+			e.recordEvent(ownerObject, true, EventReasonExposeFailed, "Failed to expose snapshot %s/%s: %v", csiExposeParam.SourceNamespace, csiExposeParam.SnapshotName, err)
 		}
 	}()
 
-	backupPod, err := e.createBackupPod(
-		ctx,
-		ownerObject,
-		backupPVC,
-		csiExposeParam.OperationTimeout,
-		csiExposeParam.HostingPodLabels,
-		csiExposeParam.HostingPodAnnotations,
-		csiExposeParam.Affinity,
-		csiExposeParam.Resources,
-		backupPVCReadOnly,
-		spcNoRelabeling,
-		csiExposeParam.NodeOS,
-	)
-	if err != nil {
-		return errors.Wrap(err, "error to create backup pod")
+	if csiExposeParam.DryRun {
+		plan, err := e.PlanExpose(ctx, ownerObject, csiExposeParam)
+		if err != nil {
+			return err
+		}
+
+		curLog.Infof("Dry run of exposing CSI snapshot: %s", plan.String())
+		return nil
 	}
 
-	curLog.WithField("pod name", backupPod.Name).WithField("affinity", csiExposeParam.Affinity).Info("Backup pod is created")
+	curLog.Info("Exposing CSI snapshot")
 
+	e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonProgressing, "Exposing snapshot %s/%s", csiExposeParam.SourceNamespace, csiExposeParam.SnapshotName)
 	defer func() {
 		if err != nil {
-			kube.DeletePodIfAny(ctx, e.kubeClient.CoreV1(), backupPod.Name, backupPod.Namespace, curLog)
+			e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonFailed, "Failed to expose snapshot %s/%s: %v", csiExposeParam.SourceNamespace, csiExposeParam.SnapshotName, err)
+		} else {
+			e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonProgressing, "Snapshot %s/%s is exposed, waiting for the backup pod", csiExposeParam.SourceNamespace, csiExposeParam.SnapshotName)
 		}
 	}()
 
-	return nil
-}
+	exposeNamespace := getExposeNamespace(ownerObject, csiExposeParam.TargetNamespace)
 
-func (e *csiSnapshotExposer) GetExposed(ctx context.Context, ownerObject corev1api.ObjectReference, timeout time.Duration, param any) (*ExposeResult, error) {
-	exposeWaitParam := param.(*CSISnapshotExposeWaitParam)
+	if e.isExposed(ctx, ownerObject, exposeNamespace) {
+		curLog.Infof("Backup objects already exist in namespace %s, skip exposing", exposeNamespace)
+		e.storeExposeNamespace(ownerObject, exposeNamespace)
+		return nil
+	}
 
-	backupPodName := ownerObject.Name
-	backupPVCName := ownerObject.Name
+	settings, err := e.prepareExpose(ctx, ownerObject, csiExposeParam, curLog)
+	if err != nil {
+		return err
+	}
 
-	containerName := string(ownerObject.UID)
-	volumeName := string(ownerObject.UID)
+	if csiExposeParam.ReuseBackupPVC {
+		if err := e.deleteTerminatedBackupPod(ctx, ownerObject, exposeNamespace, csiExposeParam.OperationTimeout, curLog); err != nil {
+			return err
+		}
 
-	curLog := e.log.WithFields(logrus.Fields{
-		"owner": ownerObject.Name,
-	})
+		if backupPVC, backupVS := e.getReusableBackupPVC(ctx, ownerObject, exposeNamespace, curLog); backupPVC != nil {
+			e.storeExposeNamespace(ownerObject, exposeNamespace)
+			return e.exposeReusedBackupPVC(ctx, ownerObject, csiExposeParam, settings, backupPVC, backupVS, curLog)
+		}
+	}
 
-	pod := &corev1api.Pod{}
-	err := exposeWaitParam.NodeClient.Get(ctx, types.NamespacedName{
-		Namespace: ownerObject.Namespace,
-		Name:      backupPodName,
-	}, pod)
+	volumeSnapshot, err := e.waitVolumeSnapshotReady(ctx, csiExposeParam.SnapshotName, csiExposeParam.SourceNamespace, csiExposeParam.ExposeTimeout, curLog)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			curLog.WithField("backup pod", backupPodName).Debugf("Backup pod is not running in the current node %s", exposeWaitParam.NodeName)
-			return nil, nil
-		} else {
-			return nil, errors.Wrapf(err, "error to get backup pod %s", backupPodName)
-		}
+		return withKind(ErrSnapshotNotReady, errors.Wrapf(err, "error wait volume snapshot ready"))
 	}
 
-	curLog.WithField("pod", pod.Name).Infof("Backup pod is in running state in node %s", pod.Spec.NodeName)
+	curLog.Info("Volumesnapshot is ready")
+	e.recordEvent(ownerObject, false, EventReasonSnapshotReady, "VolumeSnapshot %s/%s is ready", volumeSnapshot.Namespace, volumeSnapshot.Name)
 
-	_, err = kube.WaitPVCBound(ctx, e.kubeClient.CoreV1(), e.kubeClient.CoreV1(), backupPVCName, ownerObject.Namespace, timeout)
+	plan, err := e.resolveExposePlan(ctx, volumeSnapshot, csiExposeParam, settings, curLog)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error to wait backup PVC bound, %s", backupPVCName)
+		return err
 	}
 
-	curLog.WithField("backup pvc", backupPVCName).Info("Backup PVC is bound")
+	curLog.Infof("Resolved the plan of exposing CSI snapshot: %s", plan.String())
 
-	i := 0
-	for i = 0; i < len(pod.Spec.Volumes); i++ {
-		if pod.Spec.Volumes[i].Name == volumeName {
-			break
+	nodeOS := plan.NodeOS
+	span.SetAttributes(attribute.String(traceAttrNodeOS, nodeOS))
+
+	if csiExposeParam.NodeName != "" {
+		if err := e.validateBackupNode(ctx, csiExposeParam.NodeName, nodeOS, csiExposeParam.NodeOSLabelKeys); err != nil {
+			return err
 		}
 	}
 
-	if i == len(pod.Spec.Volumes) {
-		return nil, errors.Errorf("backup pod %s doesn't have the expected backup volume", pod.Name)
+	vsc, err := csi.GetVolumeSnapshotContentForVolumeSnapshot(volumeSnapshot, e.csiSnapshotClient)
+	if err != nil {
+		return withKind(ErrSnapshotContentNotFound, errors.Wrap(err, "error to get volume snapshot content"))
 	}
 
-	curLog.WithField("pod", pod.Name).Infof("Backup volume is found in pod at index %v", i)
+	curLog.WithField("vsc name", vsc.Name).WithField("vs name", volumeSnapshot.Name).Infof("Got VSC from VS in namespace %s", volumeSnapshot.Namespace)
 
-	var nodeOS *string
-	if os, found := pod.Spec.NodeSelector[kube.NodeOSLabel]; found {
-		nodeOS = &os
+	if err := e.validateSourceVolumeMode(ctx, volumeSnapshot, vsc, csiExposeParam.AccessMode, curLog); err != nil {
+		return err
 	}
 
-	return &ExposeResult{ByPod: ExposeByPod{
-		HostingPod:       pod,
-		HostingContainer: containerName,
-		VolumeName:       volumeName,
-		NodeOS:           nodeOS,
-	}}, nil
-}
-
-func (e *csiSnapshotExposer) PeekExposed(ctx context.Context, ownerObject corev1api.ObjectReference) error {
-	backupPodName := ownerObject.Name
+	if len(csiExposeParam.PreserveSourcePVCAnnotations) > 0 {
+		settings.annotations, err = e.getSourcePVCAnnotations(ctx, volumeSnapshot, csiExposeParam.PreserveSourcePVCAnnotations, curLog)
+		if err != nil {
+			return err
+		}
+	}
 
-	curLog := e.log.WithFields(logrus.Fields{
-		"owner": ownerObject.Name,
-	})
+	backupVSClass := plan.BackupVolumeSnapshotClass
 
-	pod, err := e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(ctx, backupPodName, metav1.GetOptions{})
-	if apierrors.IsNotFound(err) {
-		return nil
+	release, err := e.exposeGate.acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error to wait for the slot to expose")
 	}
+	defer release()
 
-	if err != nil {
-		curLog.WithError(err).Warnf("error to peek backup pod %s", backupPodName)
-		return nil
+	if err := e.reconcileSourceSnapshotChange(ctx, ownerObject, exposeNamespace, vsc, csiExposeParam.RebuildOnSourceSnapshotChange, csiExposeParam.OperationTimeout, curLog); err != nil {
+		return err
 	}
 
-	if podFailed, message := kube.IsPodUnrecoverable(pod, curLog); podFailed {
-		return errors.New(message)
+	backupPVCSize := plan.VolumeSize
+
+	if csiExposeParam.StorageClassMaxSizeKey != "" {
+		if err := e.checkStorageClassMaxSize(ctx, settings.storageClass, csiExposeParam.StorageClassMaxSizeKey, backupPVCSize); err != nil {
+			return err
+		}
 	}
 
-	return nil
-}
+	var sourceSnapshotLabels map[string]string
+	if !boolptr.IsSetToFalse(csiExposeParam.PreserveSnapshotLabels) {
+		sourceSnapshotLabels = volumeSnapshot.Labels
+	}
 
-func (e *csiSnapshotExposer) DiagnoseExpose(ctx context.Context, ownerObject corev1api.ObjectReference) string {
-	backupPodName := ownerObject.Name
-	backupPVCName := ownerObject.Name
-	backupVSName := ownerObject.Name
+	backupSnapshotLabels := mergeSnapshotLabels(getExposeOwnerLabels(ownerObject), sourceSnapshotLabels)
 
-	diag := "begin diagnose CSI exposer\n"
+	e.storeExposeNamespace(ownerObject, exposeNamespace)
 
-	pod, err := e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(ctx, backupPodName, metav1.GetOptions{})
+	backupVS, vsAdopted, err := e.createBackupVS(ctx, ownerObject, exposeNamespace, volumeSnapshot, backupSnapshotLabels, backupVSClass, csiExposeParam.OperationTimeout)
 	if err != nil {
-		pod = nil
-		diag += fmt.Sprintf("error getting backup pod %s, err: %v\n", backupPodName, err)
+		return withKind(ErrBackupSnapshotCreateFailed, errors.Wrap(err, "error to create backup volume snapshot"))
 	}
 
-	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(ctx, backupPVCName, metav1.GetOptions{})
-	if err != nil {
-		pvc = nil
-		diag += fmt.Sprintf("error getting backup pvc %s, err: %v\n", backupPVCName, err)
-	}
+	curLog = curLog.WithField("backupVS", backupVS.Name)
 
-	vs, err := e.csiSnapshotClient.VolumeSnapshots(ownerObject.Namespace).Get(ctx, backupVSName, metav1.GetOptions{})
-	if err != nil {
-		vs = nil
-		diag += fmt.Sprintf("error getting backup vs %s, err: %v\n", backupVSName, err)
-	}
+	if !vsAdopted {
+		curLog.Infof("Backup VS is created from %s/%s", volumeSnapshot.Namespace, volumeSnapshot.Name)
+		e.recordEvent(ownerObject, false, EventReasonBackupVSCreated, "Backup VS %s/%s is created from %s/%s", backupVS.Namespace, backupVS.Name, volumeSnapshot.Namespace, volumeSnapshot.Name)
 
-	if pod != nil {
-		diag += kube.DiagnosePod(pod)
+		defer func() {
+			if err != nil {
+				cleanUpCtx, cancel := newCleanUpContext(ctx)
+				defer cancel()
 
-		if pod.Spec.NodeName != "" {
-			if err := nodeagent.KbClientIsRunningInNode(ctx, ownerObject.Namespace, pod.Spec.NodeName, e.kubeClient); err != nil {
-				diag += fmt.Sprintf("node-agent is not running in node %s, err: %v\n", pod.Spec.NodeName, err)
+				csi.DeleteVolumeSnapshotIfAny(cleanUpCtx, e.csiSnapshotClient, backupVS.Name, backupVS.Namespace, curLog)
 			}
-		}
+		}()
 	}
 
-	if pvc != nil {
-		diag += kube.DiagnosePVC(pvc)
+	backupVSCDeletionPolicy := snapshotv1api.VolumeSnapshotContentDelete
+	if csiExposeParam.SkipSourceSnapshotRetain {
+		backupVSCDeletionPolicy = snapshotv1api.VolumeSnapshotContentRetain
+	}
+
+	backupVSC, vscAdopted, err := e.createBackupVSC(ctx, ownerObject, vsc, backupVS, backupSnapshotLabels, backupVSClass, backupVSCDeletionPolicy)
+	if err != nil {
+		return withKind(ErrBackupSnapshotCreateFailed, errors.Wrap(err, "error to create backup volume snapshot content"))
+	}
+
+	curLog = curLog.WithField("backupVSC", backupVSC.Name)
+
+	if !vscAdopted {
+		curLog.Infof("Backup VSC is created from %s", vsc.Name)
+	}
+
+	if e.backupVSCFinalizer {
+		if err = e.addExposeFinalizer(ctx, backupVSC.Name); err != nil {
+			return withKind(ErrBackupSnapshotCreateFailed, errors.Wrap(err, "error to add finalizer to backup volume snapshot content"))
+		}
+
+		curLog.Info("Finalizer is added to backup VSC")
+
+		// The deferred deletion of the backup VS runs after this, so the backup VSC is not blocked from being deleted along with it
+		defer func() {
+			if err != nil && !vscAdopted {
+				cleanUpCtx, cancel := newCleanUpContext(ctx)
+				defer cancel()
+
+				if err := e.removeExposeFinalizer(cleanUpCtx, backupVSC.Name); err != nil {
+					curLog.WithError(err).Warnf("Failed to remove finalizer from backup VSC %s", backupVSC.Name)
+				}
+			}
+		}()
+	}
+
+	if csiExposeParam.VerifyBackupVSBinding {
+		if err = e.waitBackupVSBound(ctx, backupVS, backupVSC.Name, csiExposeParam.OperationTimeout); err != nil {
+			return withKind(ErrBackupSnapshotCreateFailed, err)
+		}
+
+		curLog.Info("Backup VS is bound to backup VSC")
+	}
+
+	if csiExposeParam.SkipSourceSnapshotRetain {
+		curLog.WithField("vsc name", vsc.Name).WithField("vs name", volumeSnapshot.Name).Info("Skip retaining and deleting the source snapshot")
+	} else {
+		if err = e.retainAndDeleteSourceSnapshot(ctx, volumeSnapshot, vsc, csiExposeParam.OperationTimeout, curLog); err != nil {
+			return withKind(ErrSourceSnapshotCleanupFailed, err)
+		}
+	}
+
+	var snapshotEnv []corev1api.EnvVar
+	if csiExposeParam.InjectSnapshotMetadataEnv {
+		snapshotEnv = []corev1api.EnvVar{
+			{Name: EnvSnapshotDriver, Value: vsc.Spec.Driver},
+			{Name: EnvSnapshotClass, Value: backupVSClass},
+			{Name: EnvRestoreSize, Value: formatQuantity(plan.RestoreSize)},
+			{Name: EnvSourceNamespace, Value: csiExposeParam.SourceNamespace},
+		}
+	}
+
+	return e.exposeBackupVolume(ctx, ownerObject, csiExposeParam, settings, backupVS.Name, backupVSC, backupPVCSize, nodeOS, snapshotEnv, curLog)
+}
+
+// retainAndDeleteSourceSnapshot patches the source VSC to Retain, so that the snapshot is kept for the backup VSC, and then deletes the source VS and VSC
+func (e *csiSnapshotExposer) retainAndDeleteSourceSnapshot(ctx context.Context, volumeSnapshot *snapshotv1api.VolumeSnapshot, vsc *snapshotv1api.VolumeSnapshotContent,
+	operationTimeout time.Duration, curLog logrus.FieldLogger) error {
+	retained, err := csi.RetainVSC(ctx, e.csiSnapshotClient, vsc)
+	if err != nil {
+		return errors.Wrap(err, "error to retain volume snapshot content")
+	}
+
+	curLog.WithField("vsc name", vsc.Name).WithField("retained", (retained != nil)).Info("Finished to retain VSC")
+
+	err = csi.EnsureDeleteVS(ctx, e.csiSnapshotClient, volumeSnapshot.Name, volumeSnapshot.Namespace, operationTimeout)
+	if err != nil {
+		return errors.Wrap(err, "error to delete volume snapshot")
+	}
+
+	curLog.WithField("vs name", volumeSnapshot.Name).Infof("VS is deleted in namespace %s", volumeSnapshot.Namespace)
+
+	err = csi.EnsureDeleteVSC(ctx, e.csiSnapshotClient, vsc.Name, operationTimeout)
+	if err != nil {
+		return errors.Wrap(err, "error to delete volume snapshot content")
+	}
+
+	curLog.WithField("vsc name", vsc.Name).Infof("VSC is deleted")
+
+	return nil
+}
+
+// waitVolumeSnapshotReady waits the snapshot to be ready to use, by the shared informer if it is enabled or by polling otherwise.
+// It returns ErrSnapshotFailed as soon as the snapshot reports a non-transient error, instead of waiting until timeout
+func (e *csiSnapshotExposer) waitVolumeSnapshotReady(ctx context.Context, name string, namespace string, timeout time.Duration, log logrus.FieldLogger) (*snapshotv1api.VolumeSnapshot, error) {
+	var vs *snapshotv1api.VolumeSnapshot
+	var err error
+	if e.snapshotReadyWatcher != nil {
+		vs, err = e.snapshotReadyWatcher.waitReady(ctx, name, namespace, timeout, log)
+	} else {
+		vs, err = csi.WaitVolumeSnapshotReady(ctx, e.csiSnapshotClient, name, namespace, timeout, log)
+	}
+
+	var failure *csi.VolumeSnapshotFailure
+	if errors.As(err, &failure) {
+		return nil, withKind(ErrSnapshotFailed, err)
+	}
+
+	return vs, err
+}
+
+// PlanExpose resolves what Expose would do for the snapshot and runs all the validations, without creating or changing any object.
+// Unlike Expose, it doesn't wait for the snapshot to be ready, so the plan may miss the snapshot content and driver.
+func (e *csiSnapshotExposer) PlanExpose(ctx context.Context, ownerObject corev1api.ObjectReference, param *CSISnapshotExposeParam) (*ExposePlan, error) {
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner": ownerObject.Name,
+	})
+
+	settings, err := e.validateExpose(ctx, ownerObject, param, curLog)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.snapshotController != nil {
+		if err := e.checkSnapshotController(ctx, curLog); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := getVolumeModeByAccessMode(param.AccessMode); err != nil {
+		return nil, err
+	}
+
+	volumeSnapshot, err := e.csiSnapshotClient.VolumeSnapshots(param.SourceNamespace).Get(ctx, param.SnapshotName, metav1.GetOptions{})
+	if err != nil {
+		return nil, withKind(ErrSnapshotNotReady, errors.Wrapf(err, "error to get volume snapshot %s/%s", param.SourceNamespace, param.SnapshotName))
+	}
+
+	plan, err := e.resolveExposePlan(ctx, volumeSnapshot, param, settings, curLog)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.SnapshotContent != "" {
+		vsc, err := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, plan.SnapshotContent, metav1.GetOptions{})
+		if err != nil {
+			return nil, withKind(ErrSnapshotContentNotFound, errors.Wrapf(err, "error to get volume snapshot content %s", plan.SnapshotContent))
+		}
+
+		plan.Driver = vsc.Spec.Driver
+
+		if err := e.validateSourceVolumeMode(ctx, volumeSnapshot, vsc, param.AccessMode, curLog); err != nil {
+			return nil, err
+		}
+	}
+
+	if plan.BackupVolumeSnapshotClass != "" && plan.Driver != "" {
+		vsClass, err := e.csiSnapshotClient.VolumeSnapshotClasses().Get(ctx, plan.BackupVolumeSnapshotClass, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error to get volume snapshot class %s", plan.BackupVolumeSnapshotClass)
+		}
+
+		if vsClass.Driver != plan.Driver {
+			return nil, withKind(ErrInvalidExposeParam, errors.Errorf("driver %s of volume snapshot class %s doesn't match driver %s of the snapshot",
+				vsClass.Driver, plan.BackupVolumeSnapshotClass, plan.Driver))
+		}
+	}
+
+	if plan.BackupPVCStorageClass != "" {
+		if _, err := e.kubeClient.StorageV1().StorageClasses().Get(ctx, plan.BackupPVCStorageClass, metav1.GetOptions{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, withKind(ErrInvalidExposeParam, errors.Errorf("storage class %s for backup PVC doesn't exist", plan.BackupPVCStorageClass))
+			}
+
+			return nil, errors.Wrapf(err, "error to get storage class %s", plan.BackupPVCStorageClass)
+		}
+
+		if param.StorageClassMaxSizeKey != "" {
+			if err := e.checkStorageClassMaxSize(ctx, plan.BackupPVCStorageClass, param.StorageClassMaxSizeKey, plan.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if param.NodeName != "" {
+		if err := e.validateBackupNode(ctx, param.NodeName, plan.NodeOS, param.NodeOSLabelKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// ResolveExposePlan resolves the settings Expose uses for the snapshot, i.e., the restore size, the storage class, access mode and volume mode
+// of the backupPVC mapped by BackupPVCConfig, the read-only and spcNoRelabeling decisions and the node OS, e.g., to report them in the status
+// of the owner before any object is created. Unlike PlanExpose, it doesn't check the existence of the referred objects.
+func (e *csiSnapshotExposer) ResolveExposePlan(ctx context.Context, ownerObject corev1api.ObjectReference, param *CSISnapshotExposeParam) (*ExposePlan, error) {
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner": ownerObject.Name,
+	})
+
+	settings, err := e.validateExpose(ctx, ownerObject, param, curLog)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := getVolumeModeByAccessMode(param.AccessMode); err != nil {
+		return nil, err
+	}
+
+	volumeSnapshot, err := e.csiSnapshotClient.VolumeSnapshots(param.SourceNamespace).Get(ctx, param.SnapshotName, metav1.GetOptions{})
+	if err != nil {
+		return nil, withKind(ErrSnapshotNotReady, errors.Wrapf(err, "error to get volume snapshot %s/%s", param.SourceNamespace, param.SnapshotName))
+	}
+
+	return e.resolveExposePlan(ctx, volumeSnapshot, param, settings, curLog)
+}
+
+// resolveExposePlan resolves the plan from the snapshot and the backupPVC settings. It is shared by ResolveExposePlan, PlanExpose and Expose,
+// so that the plan always matches what Expose creates
+func (e *csiSnapshotExposer) resolveExposePlan(ctx context.Context, volumeSnapshot *snapshotv1api.VolumeSnapshot, param *CSISnapshotExposeParam, settings *backupPVCSettings,
+	log logrus.FieldLogger) (*ExposePlan, error) {
+	plan := &ExposePlan{
+		SnapshotNamespace:     volumeSnapshot.Namespace,
+		SnapshotName:          volumeSnapshot.Name,
+		SnapshotReady:         volumeSnapshot.Status != nil && boolptr.IsSetToTrue(volumeSnapshot.Status.ReadyToUse),
+		BackupPVCStorageClass: settings.storageClass,
+		BackupPVCAccessMode:   getBackupPVCAccessMode(settings.readOnly),
+		BackupPVCReadOnly:     settings.readOnly,
+		SPCNoRelabeling:       settings.spcNoRelabeling,
+		RestoreSize:           param.VolumeSize,
+		NodeOS:                param.NodeOS,
+	}
+
+	// the unsupported access mode is rejected by PlanExpose and ResolveExposePlan, or by Expose when creating the backupPVC
+	if volumeMode, err := getVolumeModeByAccessMode(param.AccessMode); err == nil {
+		plan.VolumeMode = volumeMode
+	}
+
+	var err error
+	plan.BackupVolumeSnapshotClass, err = e.resolveBackupVolumeSnapshotClass(ctx, volumeSnapshot, param, log)
+	if err != nil {
+		return nil, withKind(ErrBackupSnapshotCreateFailed, err)
+	}
+
+	if plan.BackupVolumeSnapshotClass == "" && volumeSnapshot.Spec.VolumeSnapshotClassName != nil {
+		plan.BackupVolumeSnapshotClass = *volumeSnapshot.Spec.VolumeSnapshotClassName
+	}
+
+	if volumeSnapshot.Status != nil {
+		if volumeSnapshot.Status.BoundVolumeSnapshotContentName != nil {
+			plan.SnapshotContent = *volumeSnapshot.Status.BoundVolumeSnapshotContentName
+		}
+
+		plan.RestoreSize, err = resolveVolumeSize(volumeSnapshot.Status.RestoreSize, param, log.WithField("vs name", volumeSnapshot.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plan.VolumeSize, err = addVolumeSizeOverhead(plan.RestoreSize, param.VolumeSizeOverhead, log)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.VolumeSize = roundUpToMinVolumeSize(plan.VolumeSize, settings.minVolumeSize, log)
+
+	if plan.NodeOS == "" {
+		plan.NodeOS = e.detectNodeOS(ctx, volumeSnapshot, log)
+	}
+
+	return plan, nil
+}
+
+// ExposeFromSnapshotHandle exposes a pre-provisioned snapshot identified by the snapshot handle, e.g., a snapshot created outside Velero
+// which has no dynamically created VS. The backup VSC is built from the handle, driver and class directly, and then the backup VS is bound to it.
+// Since the snapshot is not owned by the expose, the backup VSC is created with the Retain deletion policy and there is no source snapshot to delete.
+// The other fields of param take effect as in Expose, except that SnapshotName is ignored and VolumeSize is required.
+func (e *csiSnapshotExposer) ExposeFromSnapshotHandle(ctx context.Context, ownerObject corev1api.ObjectReference, snapshotHandle string, driver string, vsClass string,
+	param *CSISnapshotExposeParam) (err error) {
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner":  ownerObject.Name,
+		"handle": snapshotHandle,
+	})
+
+	ctx, span := startSpan(ctx, "CSISnapshotExposer.ExposeFromSnapshotHandle", ownerObject, attribute.String(traceAttrSnapshot, snapshotHandle))
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	defer func() {
+		if err != nil {
+			e.recordEvent(ownerObject, true, EventReasonExposeFailed, "Failed to expose snapshot handle %s: %v", snapshotHandle, err)
+		}
+	}()
+
+	curLog.Info("Exposing CSI snapshot from snapshot handle")
+
+	e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonProgressing, "Exposing snapshot handle %s", snapshotHandle)
+	defer func() {
+		if err != nil {
+			e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonFailed, "Failed to expose snapshot handle %s: %v", snapshotHandle, err)
+		} else {
+			e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonProgressing, "Snapshot handle %s is exposed, waiting for the backup pod", snapshotHandle)
+		}
+	}()
+
+	if snapshotHandle == "" || driver == "" {
+		return withKind(ErrInvalidExposeParam, errors.New("snapshot handle and driver are required"))
+	}
+
+	if param.VolumeSize.IsZero() {
+		return withKind(ErrInvalidExposeParam, errors.New("volume size is required to expose snapshot handle"))
+	}
+
+	if vsClass == "" {
+		vsClass = param.BackupVolumeSnapshotClass
+	}
+
+	settings, err := e.prepareExpose(ctx, ownerObject, param, curLog)
+	if err != nil {
+		return err
+	}
+
+	nodeOS := param.NodeOS
+	if nodeOS == "" {
+		nodeOS = kube.NodeOSLinux
+	}
+
+	span.SetAttributes(attribute.String(traceAttrNodeOS, nodeOS))
+
+	if param.NodeName != "" {
+		if err := e.validateBackupNode(ctx, param.NodeName, nodeOS, param.NodeOSLabelKeys); err != nil {
+			return err
+		}
+	}
+
+	backupPVCSize, err := addVolumeSizeOverhead(param.VolumeSize, param.VolumeSizeOverhead, curLog)
+	if err != nil {
+		return err
+	}
+
+	backupPVCSize = roundUpToMinVolumeSize(backupPVCSize, settings.minVolumeSize, curLog)
+
+	if param.StorageClassMaxSizeKey != "" {
+		if err := e.checkStorageClassMaxSize(ctx, settings.storageClass, param.StorageClassMaxSizeKey, backupPVCSize); err != nil {
+			return err
+		}
+	}
+
+	release, err := e.exposeGate.acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error to wait for the slot to expose")
+	}
+	defer release()
+
+	exposeNamespace := getExposeNamespace(ownerObject, param.TargetNamespace)
+	e.storeExposeNamespace(ownerObject, exposeNamespace)
+
+	backupVSC, err := e.createStaticBackupVSC(ctx, ownerObject, exposeNamespace, snapshotHandle, driver, vsClass, getExposeOwnerLabels(ownerObject))
+	if err != nil {
+		return withKind(ErrBackupSnapshotCreateFailed, errors.Wrap(err, "error to create backup volume snapshot content"))
+	}
+
+	curLog = curLog.WithField("backupVSC", backupVSC.Name)
+	curLog.Info("Backup VSC is created from snapshot handle")
+
+	defer func() {
+		if err != nil {
+			cleanUpCtx, cancel := newCleanUpContext(ctx)
+			defer cancel()
+
+			csi.DeleteVolumeSnapshotContentIfAny(cleanUpCtx, e.csiSnapshotClient, backupVSC.Name, curLog)
+		}
+	}()
+
+	backupVS, vsAdopted, err := e.createBackupVS(ctx, ownerObject, exposeNamespace, &snapshotv1api.VolumeSnapshot{}, getExposeOwnerLabels(ownerObject), vsClass, param.OperationTimeout)
+	if err != nil {
+		return withKind(ErrBackupSnapshotCreateFailed, errors.Wrap(err, "error to create backup volume snapshot"))
+	}
+
+	curLog = curLog.WithField("backupVS", backupVS.Name)
+
+	if !vsAdopted {
+		curLog.Info("Backup VS is created for backup VSC")
+		e.recordEvent(ownerObject, false, EventReasonBackupVSCreated, "Backup VS %s/%s is created from snapshot handle %s", backupVS.Namespace, backupVS.Name, snapshotHandle)
+
+		defer func() {
+			if err != nil {
+				cleanUpCtx, cancel := newCleanUpContext(ctx)
+				defer cancel()
+
+				csi.DeleteVolumeSnapshotIfAny(cleanUpCtx, e.csiSnapshotClient, backupVS.Name, backupVS.Namespace, curLog)
+			}
+		}()
+	}
+
+	if param.VerifyBackupVSBinding {
+		if err = e.waitBackupVSBound(ctx, backupVS, backupVSC.Name, param.OperationTimeout); err != nil {
+			return withKind(ErrBackupSnapshotCreateFailed, err)
+		}
+
+		curLog.Info("Backup VS is bound to backup VSC")
+	}
+
+	var snapshotEnv []corev1api.EnvVar
+	if param.InjectSnapshotMetadataEnv {
+		snapshotEnv = []corev1api.EnvVar{
+			{Name: EnvSnapshotDriver, Value: driver},
+			{Name: EnvSnapshotClass, Value: vsClass},
+			{Name: EnvRestoreSize, Value: formatQuantity(param.VolumeSize)},
+			{Name: EnvSourceNamespace, Value: param.SourceNamespace},
+		}
+	}
+
+	return e.exposeBackupVolume(ctx, ownerObject, param, settings, backupVS.Name, backupVSC, backupPVCSize, nodeOS, snapshotEnv, curLog)
+}
+
+func (e *csiSnapshotExposer) GetExposed(ctx context.Context, ownerObject corev1api.ObjectReference, timeout time.Duration, param any) (result *ExposeResult, err error) {
+	exposeWaitParam := param.(*CSISnapshotExposeWaitParam)
+
+	ctx, span := startSpan(ctx, "CSISnapshotExposer.GetExposed", ownerObject, attribute.String(traceAttrNode, exposeWaitParam.NodeName))
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	defer func() {
+		if err != nil {
+			e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonFailed, "Failed to get the exposed snapshot: %v", err)
+		} else if result != nil {
+			e.setOwnerCondition(ctx, ownerObject, ExposeConditionReasonReady, "Backup pod %s is running in node %s", result.ByPod.HostingPod.Name, result.ByPod.HostingPod.Spec.NodeName)
+		}
+	}()
+
+	backupPodName := ownerObject.Name
+	backupPVCName := ownerObject.Name
+	exposeNamespace := e.resolveExposeNamespace(ctx, ownerObject)
+
+	// the backup container and the backup volume are both named by the expose name
+	exposeName := getExposeName(ownerObject, exposeWaitParam.NameSource)
+
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner": ownerObject.Name,
+	})
+
+	pod := &corev1api.Pod{}
+	err = exposeWaitParam.NodeClient.Get(ctx, types.NamespacedName{
+		Namespace: exposeNamespace,
+		Name:      backupPodName,
+	}, pod)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			curLog.WithField("backup pod", backupPodName).Debugf("Backup pod is not running in the current node %s", exposeWaitParam.NodeName)
+			return nil, nil
+		} else {
+			return nil, errors.Wrapf(err, "error to get backup pod %s", backupPodName)
+		}
+	}
+
+	curLog.WithField("pod", pod.Name).Infof("Backup pod is in running state in node %s", pod.Spec.NodeName)
+	span.SetAttributes(attribute.String(traceAttrNode, pod.Spec.NodeName))
+
+	if e.exposeResultCache != nil {
+		if cached := e.exposeResultCache.get(ownerObject, pod.ResourceVersion); cached != nil {
+			curLog.WithField("pod", pod.Name).Debugf("Use the cached expose result of resource version %s", pod.ResourceVersion)
+			return cached, nil
+		}
+	}
+
+	pv, err := kube.WaitPVCBound(ctx, e.kubeClient.CoreV1(), e.kubeClient.CoreV1(), backupPVCName, exposeNamespace, timeout)
+	if err != nil {
+		e.recordEvent(ownerObject, true, EventReasonGetExposedFailed, "Failed to wait backup PVC %s/%s bound: %v", exposeNamespace, backupPVCName, err)
+		return nil, errors.Wrapf(err, "error to wait backup PVC bound, %s", backupPVCName)
+	}
+
+	curLog.WithField("backup pvc", backupPVCName).Info("Backup PVC is bound")
+	e.recordEvent(ownerObject, false, EventReasonBackupPVCBound, "Backup PVC %s/%s is bound to PV %s", exposeNamespace, backupPVCName, pv.Name)
+
+	if e.backupVSCFinalizer {
+		if err := e.removeExposeFinalizer(ctx, ownerObject.Name); err != nil {
+			curLog.WithError(err).Warnf("Failed to remove finalizer from backup VSC %s, it is left to cleanup", ownerObject.Name)
+		}
+	}
+
+	if exposeWaitParam.ForcePVReclaimDelete && !isStaticBackupPV(pv) {
+		if err := e.setBackupPVReclaimDelete(ctx, pv); err != nil {
+			return nil, errors.Wrapf(err, "error to set reclaim policy of backup PV %s", pv.Name)
+		}
+
+		curLog.WithField("backup pv", pv.Name).Infof("Reclaim policy of backup PV is set to Delete from %s", pv.Spec.PersistentVolumeReclaimPolicy)
+	}
+
+	if hasReadinessProbe(pod, exposeName) {
+		readyPod, err := e.waitBackupPodReady(ctx, pod, timeout)
+		if err != nil {
+			e.recordEvent(ownerObject, true, EventReasonGetExposedFailed, "Failed to wait backup pod %s/%s ready: %v", pod.Namespace, pod.Name, err)
+			return nil, errors.Wrapf(err, "error to wait backup pod ready, %s", pod.Name)
+		}
+
+		pod = readyPod
+		curLog.WithField("pod", pod.Name).Info("Backup pod is ready")
+	}
+
+	volumes := getExposedBackupVolumes(pod, exposeName, exposeName)
+	if len(volumes) == 0 {
+		return nil, errors.Errorf("backup pod %s doesn't have the expected backup volume", pod.Name)
+	}
+
+	curLog.WithField("pod", pod.Name).Infof("%v backup volumes are found in pod", len(volumes))
+
+	var nodeOS *string
+	if os, found := pod.Spec.NodeSelector[kube.NodeOSLabel]; found {
+		nodeOS = &os
+	} else if pod.Spec.OS != nil && pod.Spec.OS.Name != "" {
+		os := string(pod.Spec.OS.Name)
+		nodeOS = &os
+	}
+
+	if nodeOS != nil {
+		span.SetAttributes(attribute.String(traceAttrNodeOS, *nodeOS))
+	}
+
+	result = &ExposeResult{ByPod: ExposeByPod{
+		HostingPod:       pod,
+		HostingContainer: volumes[0].ContainerName,
+		VolumeName:       volumes[0].VolumeName,
+		NodeOS:           nodeOS,
+		Scheduling:       getExposeScheduling(pod),
+		Volumes:          volumes,
+	}}
+
+	if e.exposeResultCache != nil {
+		e.exposeResultCache.set(ownerObject, pod.ResourceVersion, result)
+	}
+
+	return result, nil
+}
+
+func (e *csiSnapshotExposer) PeekExposed(ctx context.Context, ownerObject corev1api.ObjectReference) error {
+	backupPodName := ownerObject.Name
+
+	curLog := e.log.WithFields(logrus.Fields{
+		"owner": ownerObject.Name,
+	})
+
+	pod, err := e.kubeClient.CoreV1().Pods(e.resolveExposeNamespace(ctx, ownerObject)).Get(ctx, backupPodName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		curLog.WithError(err).Warnf("error to peek backup pod %s", backupPodName)
+		return nil
+	}
+
+	if podFailed, message := kube.IsPodUnrecoverable(pod, curLog); podFailed {
+		return newPeekError(pod, message)
+	}
+
+	if exceeded, message := isPodRestartLimitExceeded(pod, getBackupContainerName(pod, ownerObject)); exceeded {
+		return newPeekError(pod, message)
+	}
+
+	if e.nodeNotReadyGrace > 0 && pod.Spec.NodeName != "" {
+		node, err := e.kubeClient.CoreV1().Nodes().Get(ctx, pod.Spec.NodeName, metav1.GetOptions{})
+		if err != nil {
+			curLog.WithError(err).Warnf("error to get node %s of backup pod %s", pod.Spec.NodeName, backupPodName)
+			return nil
+		}
+
+		if since, notReady := getNodeNotReadySince(node); notReady && time.Since(since) > e.nodeNotReadyGrace {
+			return withKind(ErrNodeNotReady, errors.Errorf("node %s of backup pod %s is not ready since %s", node.Name, backupPodName, since.Format(time.RFC3339)))
+		}
+	}
+
+	return nil
+}
+
+// isPodRestartLimitExceeded checks if the restarts of the data mover container exceed the restart limit recorded in the backup pod
+func isPodRestartLimitExceeded(pod *corev1api.Pod, containerName string) (bool, string) {
+	value, found := pod.Annotations[podRestartLimitAnnotation]
+	if !found {
+		return false, ""
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return false, ""
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName && int(status.RestartCount) > limit {
+			return true, fmt.Sprintf("Container %s in Pod %s/%s has restarted %d times, exceeding the limit %d, message [%s]",
+				containerName, pod.Namespace, pod.Name, status.RestartCount, limit, getLastTerminationMessage(status))
+		}
+	}
+
+	return false, ""
+}
+
+func getLastTerminationMessage(status corev1api.ContainerStatus) string {
+	if status.LastTerminationState.Terminated != nil {
+		return status.LastTerminationState.Terminated.Message
+	}
+
+	return ""
+}
+
+// getNodeNotReadySince returns whether the node is not ready and the time it has been not ready since
+func getNodeNotReadySince(node *corev1api.Node) (time.Time, bool) {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1api.NodeReady {
+			if condition.Status == corev1api.ConditionTrue {
+				return time.Time{}, false
+			}
+
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// GetExposeProgress returns the progress of the expose derived from the current state of the backup pod and backupPVC without waiting
+func (e *csiSnapshotExposer) GetExposeProgress(ctx context.Context, ownerObject corev1api.ObjectReference) (*ExposeProgress, error) {
+	backupPodName := ownerObject.Name
+	backupPVCName := ownerObject.Name
+	exposeNamespace := e.resolveExposeNamespace(ctx, ownerObject)
+
+	pod, err := e.kubeClient.CoreV1().Pods(exposeNamespace).Get(ctx, backupPodName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to get backup pod %s", backupPodName)
+	}
+
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(exposeNamespace).Get(ctx, backupPVCName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to get backup pvc %s", backupPVCName)
+	}
+
+	return getExposeProgress(pod, pvc), nil
+}
+
+func (e *csiSnapshotExposer) DiagnoseExpose(ctx context.Context, ownerObject corev1api.ObjectReference) string {
+	diag, _ := e.DiagnoseExposeStructured(ctx, ownerObject)
+	return diag.String()
+}
+
+// maxDiagnoseConcurrency is the max number of the exposes diagnosed concurrently by DiagnoseBackupExposures
+const maxDiagnoseConcurrency = 8
+
+// DiagnoseBackupExposures discovers the exposes of a backup by their backup pods matching the label selector backupLabel in namespace,
+// e.g., velero.io/backup-name=<backup name>, and diagnoses them concurrently. It returns the diagnostic info by the owner name of each expose.
+// The exposes whose backup pods are not created yet are not discovered. If ctx is canceled, the exposes not diagnosed yet are skipped and
+// the error is returned along with the diagnostic info collected so far
+func (e *csiSnapshotExposer) DiagnoseBackupExposures(ctx context.Context, namespace string, backupLabel string) (map[string]string, error) {
+	selector, err := labels.Parse(backupLabel)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to parse backup label %s", backupLabel)
+	}
+
+	groupRequirement, err := labels.NewRequirement(podGroupLabel, selection.Equals, []string{podGroupSnapshot})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to create pod group requirement")
+	}
+
+	pods, err := e.kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.Add(*groupRequirement).String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to list backup pods with label %s", backupLabel)
+	}
+
+	owners := []corev1api.ObjectReference{}
+	for _, pod := range pods.Items {
+		owner, found := getExposeOwnerOf(&pod)
+		if !found {
+			e.log.Warnf("Skip diagnosing backup pod %s/%s without owner", pod.Namespace, pod.Name)
+			continue
+		}
+
+		// The backup pod may be in a target namespace other than the owner's
+		e.storeExposeNamespace(owner, pod.Namespace)
+
+		owners = append(owners, owner)
+	}
+
+	diags := make(map[string]string, len(owners))
+	mux := sync.Mutex{}
+	limit := make(chan struct{}, maxDiagnoseConcurrency)
+	wg := sync.WaitGroup{}
+
+	for _, owner := range owners {
+		select {
+		case limit <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(owner corev1api.ObjectReference) {
+			defer wg.Done()
+			defer func() { <-limit }()
+
+			diag := e.DiagnoseExpose(ctx, owner)
+
+			mux.Lock()
+			diags[owner.Name] = diag
+			mux.Unlock()
+		}(owner)
+	}
+
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return diags, errors.Wrap(ctx.Err(), "error to diagnose backup exposures")
+	}
+
+	return diags, nil
+}
+
+// DiagnoseExposeStructured collects the diagnostic info of the expose as an ExposeDiagnosis.
+// Failures of retrieving the objects are recorded into the diagnosis instead of being returned.
+func (e *csiSnapshotExposer) DiagnoseExposeStructured(ctx context.Context, ownerObject corev1api.ObjectReference) (*ExposeDiagnosis, error) {
+	backupPodName := ownerObject.Name
+	backupPVCName := ownerObject.Name
+	backupVSName := ownerObject.Name
+	exposeNamespace := e.resolveExposeNamespace(ctx, ownerObject)
+
+	diag := &ExposeDiagnosis{}
+
+	pod, err := e.kubeClient.CoreV1().Pods(exposeNamespace).Get(ctx, backupPodName, metav1.GetOptions{})
+	if err != nil {
+		pod = nil
+		diag.PodError = fmt.Sprintf("error getting backup pod %s, err: %v", backupPodName, err)
+	}
+
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(exposeNamespace).Get(ctx, backupPVCName, metav1.GetOptions{})
+	if err != nil {
+		pvc = nil
+		diag.PVCError = fmt.Sprintf("error getting backup pvc %s, err: %v", backupPVCName, err)
+	}
+
+	vs, err := e.csiSnapshotClient.VolumeSnapshots(exposeNamespace).Get(ctx, backupVSName, metav1.GetOptions{})
+	if err != nil {
+		vs = nil
+		diag.VSError = fmt.Sprintf("error getting backup vs %s, err: %v", backupVSName, err)
+	}
+
+	if pod != nil {
+		diag.Pod = &PodDiagnosis{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			Phase:     pod.Status.Phase,
+			NodeName:  pod.Spec.NodeName,
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			diag.Pod.Conditions = append(diag.Pod.Conditions, PodConditionDiagnosis{
+				Type:    condition.Type,
+				Status:  condition.Status,
+				Reason:  condition.Reason,
+				Message: condition.Message,
+			})
+		}
+
+		if pod.Spec.NodeName != "" {
+			if err := nodeagent.KbClientIsRunningInNode(ctx, ownerObject.Namespace, pod.Spec.NodeName, e.kubeClient); err != nil {
+				diag.NodeAgentError = fmt.Sprintf("node-agent is not running in node %s, err: %v", pod.Spec.NodeName, err)
+			} else {
+				diag.NodeAgentRunning = true
+			}
+		}
+
+		if e.diagnosePodLogLines > 0 {
+			e.diagnosePodLogs(ctx, pod, getBackupContainerName(pod, ownerObject), diag)
+		}
+	}
+
+	if pvc != nil {
+		diag.PVC = &PVCDiagnosis{
+			Namespace:  pvc.Namespace,
+			Name:       pvc.Name,
+			Phase:      pvc.Status.Phase,
+			VolumeName: pvc.Spec.VolumeName,
+		}
+
+		if pvc.Status.Phase == corev1api.ClaimPending && pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+			if sc, err := e.kubeClient.StorageV1().StorageClasses().Get(ctx, *pvc.Spec.StorageClassName, metav1.GetOptions{}); err != nil {
+				e.log.WithError(err).Warnf("Failed to get storage class %s of backup pvc %s/%s", *pvc.Spec.StorageClassName, pvc.Namespace, pvc.Name)
+			} else if sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1api.VolumeBindingWaitForFirstConsumer {
+				diag.PVC.StorageClass = sc.Name
+				diag.PVC.WaitForFirstConsumer = true
+			}
+		}
 
 		if pvc.Spec.VolumeName != "" {
 			if pv, err := e.kubeClient.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{}); err != nil {
-				diag += fmt.Sprintf("error getting backup pv %s, err: %v\n", pvc.Spec.VolumeName, err)
+				diag.PVError = fmt.Sprintf("error getting backup pv %s, err: %v", pvc.Spec.VolumeName, err)
+			} else {
+				diag.PV = &PVDiagnosis{
+					Name:    pv.Name,
+					Phase:   pv.Status.Phase,
+					Reason:  pv.Status.Reason,
+					Message: pv.Status.Message,
+				}
+			}
+		}
+	}
+
+	if vs != nil {
+		diag.VS = &SnapshotDiagnosis{
+			Namespace: vs.Namespace,
+			Name:      vs.Name,
+		}
+
+		if vs.Status != nil {
+			if vs.Status.BoundVolumeSnapshotContentName != nil {
+				diag.VS.BoundTo = *vs.Status.BoundVolumeSnapshotContentName
+			}
+
+			if vs.Status.ReadyToUse != nil {
+				diag.VS.ReadyToUse = *vs.Status.ReadyToUse
+			}
+
+			if vs.Status.Error != nil && vs.Status.Error.Message != nil {
+				diag.VS.ErrorMessage = *vs.Status.Error.Message
+			}
+		}
+
+		if diag.VS.BoundTo != "" {
+			if vsc, err := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, diag.VS.BoundTo, metav1.GetOptions{}); err != nil {
+				diag.VSCError = fmt.Sprintf("error getting backup vsc %s, err: %v", diag.VS.BoundTo, err)
+			} else {
+				diag.VSC = &SnapshotDiagnosis{
+					Name: vsc.Name,
+				}
+
+				if vsc.Status != nil {
+					if vsc.Status.SnapshotHandle != nil {
+						diag.VSC.Handle = *vsc.Status.SnapshotHandle
+					}
+
+					if vsc.Status.ReadyToUse != nil {
+						diag.VSC.ReadyToUse = *vsc.Status.ReadyToUse
+					}
+
+					if vsc.Status.Error != nil && vsc.Status.Error.Message != nil {
+						diag.VSC.ErrorMessage = *vsc.Status.Error.Message
+					}
+				}
+			}
+		}
+	}
+
+	if e.diagnoseRedactor != nil {
+		e.diagnoseRedactor.redactDiagnosis(diag)
+	}
+
+	return diag, nil
+}
+
+// mergeSnapshotLabels merges the labels of the source snapshot into the Velero managed labels of the backup snapshot.
+// The reserved Velero label keys of the source snapshot are skipped, e.g., velero.io/backup-name, otherwise,
+// the backup snapshot is taken as part of the backup; the managed labels are never overwritten
+func mergeSnapshotLabels(managed map[string]string, source map[string]string) map[string]string {
+	if len(managed) == 0 && len(source) == 0 {
+		return nil
+	}
+
+	merged := make(map[string]string, len(managed)+len(source))
+	for k, v := range source {
+		if isReservedLabelKey(k) {
+			continue
+		}
+
+		merged[k] = v
+	}
+
+	for k, v := range managed {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// getExposeOwnerLabels returns the Velero managed labels recording the owner of the backup VS and VSC, which have no owner references,
+// so that CleanUp is able to verify their owner
+func getExposeOwnerLabels(ownerObject corev1api.ObjectReference) map[string]string {
+	if ownerObject.UID == "" {
+		return nil
+	}
+
+	return map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)}
+}
+
+// dataUploadKind is the kind of the DataUpload owner, whose name is labeled on the backup VS, PVC and pod
+const dataUploadKind = "DataUpload"
+
+// getDataUploadLabels returns the label of the DataUpload name stamped on the backup VS, PVC and pod if the owner is a DataUpload,
+// so that the objects of a DataUpload could be found across namespaces without walking the owner references
+func getDataUploadLabels(ownerObject corev1api.ObjectReference) map[string]string {
+	if ownerObject.Kind != dataUploadKind {
+		return nil
+	}
+
+	return map[string]string{velerov1api.DataUploadLabel: label.GetValidName(ownerObject.Name)}
+}
+
+// DataUploadSelector returns the label selector of the backup VS, PVC and pod exposed for the DataUpload of the name
+func DataUploadSelector(name string) labels.Selector {
+	return labels.SelectorFromSet(labels.Set{velerov1api.DataUploadLabel: label.GetValidName(name)})
+}
+
+// getExposeNamespace returns the namespace of the backup VS, PVC and pod, which is the target namespace if specified or the owner's namespace otherwise
+func getExposeNamespace(ownerObject corev1api.ObjectReference, targetNamespace string) string {
+	if targetNamespace != "" {
+		return targetNamespace
+	}
+
+	return ownerObject.Namespace
+}
+
+// getExposeOwnerReferences returns the controller reference to the owner for the objects of the expose in namespace.
+// Owner references can't cross namespaces, so the objects in a namespace other than the owner's have none
+func getExposeOwnerReferences(ownerObject corev1api.ObjectReference, namespace string) []metav1.OwnerReference {
+	if namespace != ownerObject.Namespace {
+		return nil
+	}
+
+	return []metav1.OwnerReference{
+		{
+			APIVersion: ownerObject.APIVersion,
+			Kind:       ownerObject.Kind,
+			Name:       ownerObject.Name,
+			UID:        ownerObject.UID,
+			Controller: boolptr.True(),
+		},
+	}
+}
+
+// getCrossNamespaceOwnerLabels returns the owner labels for the objects of the expose in a namespace other than the owner's,
+// which identify the owner and its namespace in place of the owner reference
+func getCrossNamespaceOwnerLabels(ownerObject corev1api.ObjectReference, namespace string) map[string]string {
+	if namespace == ownerObject.Namespace {
+		return nil
+	}
+
+	ownerLabels := getExposeOwnerLabels(ownerObject)
+	if ownerLabels != nil {
+		ownerLabels[exposeOwnerNamespaceLabel] = ownerObject.Namespace
+	}
+
+	return ownerLabels
+}
+
+// getExposeOwnerOf returns the owner of the object of the expose by its controller reference. For the object in a namespace other than
+// the owner's, which has no owner reference, the owner is resolved by the labels of getCrossNamespaceOwnerLabels, and only the DataUpload
+// owner whose kind is known by DataUploadLabel is resolved. It returns false if the owner can't be resolved
+func getExposeOwnerOf(obj metav1.Object) (corev1api.ObjectReference, bool) {
+	if ref := metav1.GetControllerOf(obj); ref != nil {
+		return corev1api.ObjectReference{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Namespace:  obj.GetNamespace(),
+			Name:       ref.Name,
+			UID:        ref.UID,
+		}, true
+	}
+
+	objLabels := obj.GetLabels()
+	uid := objLabels[exposeOwnerUIDLabel]
+	namespace := objLabels[exposeOwnerNamespaceLabel]
+	if uid == "" || namespace == "" {
+		return corev1api.ObjectReference{}, false
+	}
+
+	if _, found := objLabels[velerov1api.DataUploadLabel]; !found {
+		return corev1api.ObjectReference{}, false
+	}
+
+	return corev1api.ObjectReference{
+		APIVersion: velerov2alpha1api.SchemeGroupVersion.String(),
+		Kind:       dataUploadKind,
+		Namespace:  namespace,
+		Name:       obj.GetName(),
+		UID:        types.UID(uid),
+	}, true
+}
+
+// isExposeOwnedBy checks if the object of the expose belongs to the owner, by its controller reference or by exposeOwnerUIDLabel if it has none
+func isExposeOwnedBy(obj metav1.Object, ownerObject corev1api.ObjectReference) bool {
+	if ref := metav1.GetControllerOf(obj); ref != nil {
+		return ref.UID == ownerObject.UID
+	}
+
+	uid, found := obj.GetLabels()[exposeOwnerUIDLabel]
+	return found && ownerObject.UID != "" && uid == string(ownerObject.UID)
+}
+
+// storeExposeNamespace caches the namespace of the expose, so that resolveExposeNamespace doesn't need to look it up
+func (e *csiSnapshotExposer) storeExposeNamespace(ownerObject corev1api.ObjectReference, namespace string) {
+	if ownerObject.UID != "" {
+		e.exposeNamespaces.Store(ownerObject.UID, namespace)
+	}
+}
+
+// resolveExposeNamespace returns the namespace of the objects of the expose for the methods that are not given the expose param.
+// The namespace is looked up from the backup VSC, which is cluster scoped and refers to the backup VS, if it is not cached.
+// If the backup VSC doesn't exist or belongs to another owner, the owner's namespace is returned
+func (e *csiSnapshotExposer) resolveExposeNamespace(ctx context.Context, ownerObject corev1api.ObjectReference) string {
+	if ownerObject.UID != "" {
+		if namespace, found := e.exposeNamespaces.Load(ownerObject.UID); found {
+			return namespace.(string)
+		}
+	}
+
+	if e.csiSnapshotClient == nil {
+		return ownerObject.Namespace
+	}
+
+	vsc, err := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			e.log.WithField("owner", ownerObject.Name).WithError(err).Warn("Failed to get backup VSC to resolve the expose namespace")
+		}
+
+		return ownerObject.Namespace
+	}
+
+	if uid, found := vsc.Labels[exposeOwnerUIDLabel]; found && uid != string(ownerObject.UID) {
+		return ownerObject.Namespace
+	}
+
+	namespace := vsc.Spec.VolumeSnapshotRef.Namespace
+	if namespace == "" {
+		return ownerObject.Namespace
+	}
+
+	e.storeExposeNamespace(ownerObject, namespace)
+
+	return namespace
+}
+
+// isReservedLabelKey checks if the label key is in the velero.io domain or its sub domains
+func isReservedLabelKey(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return false
+	}
+
+	return prefix == veleroLabelDomain || strings.HasSuffix(prefix, "."+veleroLabelDomain)
+}
+
+const veleroLabelDomain = "velero.io"
+
+// pvcBindingAnnotationPrefixes are the prefixes of the PVC annotations managed by the PV controller for provisioning and binding,
+// copying them to the backupPVC breaks its own provisioning
+var pvcBindingAnnotationPrefixes = []string{"pv.kubernetes.io/", "volume.kubernetes.io/", "volume.beta.kubernetes.io/"}
+
+// getSourcePVCAnnotations returns the annotations of the given keys from the source PVC of the snapshot. The keys not found,
+// the Velero reserved keys and the PVC binding keys are skipped. If the source PVC doesn't exist anymore, no annotation is returned
+func (e *csiSnapshotExposer) getSourcePVCAnnotations(ctx context.Context, vs *snapshotv1api.VolumeSnapshot, keys []string, log logrus.FieldLogger) (map[string]string, error) {
+	if vs.Spec.Source.PersistentVolumeClaimName == nil || *vs.Spec.Source.PersistentVolumeClaimName == "" {
+		log.Warnf("Skip preserving source PVC annotations as VS %s/%s doesn't have source PVC", vs.Namespace, vs.Name)
+		return nil, nil
+	}
+
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(vs.Namespace).Get(ctx, *vs.Spec.Source.PersistentVolumeClaimName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Warnf("Skip preserving source PVC annotations as source PVC %s/%s is not found", vs.Namespace, *vs.Spec.Source.PersistentVolumeClaimName)
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "error to get source PVC %s/%s", vs.Namespace, *vs.Spec.Source.PersistentVolumeClaimName)
+	}
+
+	var annotations map[string]string
+	for _, key := range keys {
+		value, found := pvc.Annotations[key]
+		if !found {
+			continue
+		}
+
+		if isReservedLabelKey(key) || slices.ContainsFunc(pvcBindingAnnotationPrefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) }) {
+			log.Warnf("Skip preserving source PVC annotation %s as it is managed by Velero or Kubernetes", key)
+			continue
+		}
+
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+
+		annotations[key] = value
+	}
+
+	return annotations, nil
+}
+
+// checkSnapshotController checks the snapshot controller deployment has ready replicas.
+// Failures other than the deployment not found are ignored, so that the expose is not blocked by the missing permissions
+func (e *csiSnapshotExposer) checkSnapshotController(ctx context.Context, log logrus.FieldLogger) error {
+	deploy, err := e.kubeClient.AppsV1().Deployments(e.snapshotController.Namespace).Get(ctx, e.snapshotController.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return withKind(ErrSnapshotControllerNotRunning, errors.Errorf("snapshot controller deployment %s is not found", e.snapshotController.String()))
+		}
+
+		log.WithError(err).Warnf("Failed to get snapshot controller deployment %s, skip the preflight", e.snapshotController.String())
+		return nil
+	}
+
+	if deploy.Spec.Replicas != nil && *deploy.Spec.Replicas == 0 {
+		return withKind(ErrSnapshotControllerNotRunning, errors.Errorf("snapshot controller deployment %s is scaled down to zero", e.snapshotController.String()))
+	}
+
+	if deploy.Status.ReadyReplicas == 0 {
+		return withKind(ErrSnapshotControllerNotRunning, errors.Errorf("snapshot controller deployment %s has no ready replica", e.snapshotController.String()))
+	}
+
+	return nil
+}
+
+// resolveVolumeSize decides the size of the backup PVC from the restore size of the snapshot and the size of the source volume.
+// The restore size is preferred, unless the mismatch check is enabled, with which the larger one is used
+func resolveVolumeSize(restoreSize *resource.Quantity, param *CSISnapshotExposeParam, log logrus.FieldLogger) (resource.Quantity, error) {
+	if restoreSize == nil || restoreSize.IsZero() {
+		log.Warnf("The snapshot doesn't contain a valid restore size, use source volume's size %v", param.VolumeSize)
+		return param.VolumeSize, nil
+	}
+
+	if param.VolumeSizeMismatchTolerance <= 0 || param.VolumeSize.IsZero() {
+		return *restoreSize, nil
+	}
+
+	// compare in float, so that the percentage of a huge volume doesn't overflow
+	sourceBytes := float64(param.VolumeSize.Value())
+	diffPercent := math.Abs(float64(restoreSize.Value())-sourceBytes) * 100 / sourceBytes
+	if diffPercent > float64(param.VolumeSizeMismatchTolerance) {
+		if param.FailOnVolumeSizeMismatch {
+			return resource.Quantity{}, withKind(ErrVolumeSizeMismatch, errors.Errorf("restore size %s of the snapshot differs from the source volume size %s by %.1f%%, exceeding the tolerance %d%%",
+				formatQuantity(*restoreSize), formatQuantity(param.VolumeSize), diffPercent, param.VolumeSizeMismatchTolerance))
+		}
+
+		log.Warnf("The restore size %s of the snapshot differs from the source volume size %s by %.1f%%, exceeding the tolerance %d%%",
+			formatQuantity(*restoreSize), formatQuantity(param.VolumeSize), diffPercent, param.VolumeSizeMismatchTolerance)
+	}
+
+	if restoreSize.Cmp(param.VolumeSize) < 0 {
+		return param.VolumeSize, nil
+	}
+
+	return *restoreSize, nil
+}
+
+// formatQuantity formats the quantity for messages. The quantity is passed by value, since String caches the formatted value in
+// the quantity it is called on, which would change the objects and plans holding the quantity
+func formatQuantity(q resource.Quantity) string {
+	return q.String()
+}
+
+// roundUpToMinVolumeSize returns the minimum volume size of the storage class if the size is below it, otherwise, the size is returned as is
+func roundUpToMinVolumeSize(size resource.Quantity, minSize resource.Quantity, log logrus.FieldLogger) resource.Quantity {
+	if minSize.IsZero() || size.Cmp(minSize) >= 0 {
+		return size
+	}
+
+	log.Infof("The size %s of backup PVC is below the minimum volume size of the storage class, round it up to %s", formatQuantity(size), formatQuantity(minSize))
+
+	return minSize.DeepCopy()
+}
+
+// addVolumeSizeOverhead returns the size of the backupPVC, which is the volume size plus the overhead
+func addVolumeSizeOverhead(size resource.Quantity, overhead resource.Quantity, log logrus.FieldLogger) (resource.Quantity, error) {
+	if overhead.IsZero() {
+		return size, nil
+	}
+
+	if size.Value() > math.MaxInt64-overhead.Value() {
+		return resource.Quantity{}, withKind(ErrInvalidExposeParam, errors.Errorf("volume size %s plus the overhead %s overflows", formatQuantity(size), formatQuantity(overhead)))
+	}
+
+	total := size.DeepCopy()
+	total.Add(overhead)
+
+	log.Infof("The size of backup PVC is %s, including the overhead %s on the volume size %s", formatQuantity(total), formatQuantity(overhead), formatQuantity(size))
+
+	return total, nil
+}
+
+// checkStorageClassMaxSize checks the size of the backupPVC doesn't exceed the max size set in the storage class's annotation or parameter by the key
+func (e *csiSnapshotExposer) checkStorageClassMaxSize(ctx context.Context, storageClass string, key string, size resource.Quantity) error {
+	sc, err := e.kubeClient.StorageV1().StorageClasses().Get(ctx, storageClass, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "error to get storage class %s", storageClass)
+	}
+
+	value, found := sc.Annotations[key]
+	if !found {
+		value, found = sc.Parameters[key]
+	}
+
+	if !found {
+		return nil
+	}
+
+	maxSize, err := resource.ParseQuantity(value)
+	if err != nil {
+		return withKind(ErrInvalidExposeParam, errors.Wrapf(err, "error to parse max size %s of storage class %s", value, storageClass))
+	}
+
+	if size.Cmp(maxSize) > 0 {
+		return withKind(ErrVolumeSizeExceedsMax, errors.Errorf("size %s of backup PVC exceeds the max size %s allowed by storage class %s", size.String(), maxSize.String(), storageClass))
+	}
+
+	return nil
+}
+
+// diagnosePodLogs collects the last lines of the backup container's logs into the diagnosis
+func (e *csiSnapshotExposer) diagnosePodLogs(ctx context.Context, pod *corev1api.Pod, containerName string, diag *ExposeDiagnosis) {
+	if !isContainerStarted(pod, containerName) {
+		diag.PodLogsError = fmt.Sprintf("no logs available for backup pod %s, container %s has never started", pod.Name, containerName)
+		return
+	}
+
+	tailLines := e.diagnosePodLogLines
+	logs, err := e.kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1api.PodLogOptions{
+		Container: containerName,
+		TailLines: &tailLines,
+	}).DoRaw(ctx)
+	if err != nil {
+		diag.PodLogsError = fmt.Sprintf("error getting logs of backup pod %s, err: %v", pod.Name, err)
+		return
+	}
+
+	diag.PodLogs = string(logs)
+}
+
+// isContainerStarted checks if the container has ever started, so that there may be logs available
+func isContainerStarted(pod *corev1api.Pod, containerName string) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != containerName {
+			continue
+		}
+
+		return status.State.Running != nil || status.State.Terminated != nil || status.LastTerminationState.Terminated != nil
+	}
+
+	return false
+}
+
+const defaultDiagnosePodLogLines = 50
+
+// defaultCleanUpTimeout is the default time CleanUp waits for the backup PV and PVC to be removed
+const defaultCleanUpTimeout = time.Minute
+
+// newCleanUpContext returns the context to delete the objects created by a failed expose. It is not canceled along with ctx,
+// so that the objects are still deleted if the expose fails because ctx is done, e.g., on the shutdown of the controller
+func newCleanUpContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), defaultCleanUpTimeout)
+}
+
+var staleBackupVSPollInterval = time.Second
+
+var backupVSBindPollInterval = time.Second
+
+var backupPodReadyPollInterval = time.Second
+
+// staticBackupPVAnnotation records the owner UID in the static backup PV
+const staticBackupPVAnnotation = "velero.io/static-backup-pv"
+
+// podRestartLimitAnnotation records the restart limit of the data mover container in the backup pod
+const podRestartLimitAnnotation = "velero.io/restart-limit"
+
+// originalReclaimPolicyAnnotation records the reclaim policy of the backup PV before it is forced to Delete
+const originalReclaimPolicyAnnotation = "velero.io/original-reclaim-policy"
+
+// sidecarExclusionAnnotations are the well-known annotations to opt the backup pod out of service mesh sidecar injection
+var sidecarExclusionAnnotations = map[string]string{
+	"sidecar.istio.io/inject":   "false",
+	"linkerd.io/inject":         "disabled",
+	"kuma.io/sidecar-injection": "disabled",
+}
+
+// getCleanUpTimeout returns the time CleanUp waits for the backup PV and PVC to be removed
+func (e *csiSnapshotExposer) getCleanUpTimeout() time.Duration {
+	if e.cleanUpTimeout <= 0 {
+		return defaultCleanUpTimeout
+	}
+
+	return e.cleanUpTimeout
+}
+
+func (e *csiSnapshotExposer) CleanUp(ctx context.Context, ownerObject corev1api.ObjectReference, vsName string, sourceNamespace string) {
+	ctx, span := startSpan(ctx, "CSISnapshotExposer.CleanUp", ownerObject, attribute.String(traceAttrSnapshot, sourceNamespace+"/"+vsName))
+	defer span.End()
+
+	backupPodName := ownerObject.Name
+	backupPVCName := ownerObject.Name
+	backupVSName := ownerObject.Name
+	backupVSCName := ownerObject.Name
+	exposeNamespace := e.resolveExposeNamespace(ctx, ownerObject)
+
+	if e.exposeResultCache != nil {
+		e.exposeResultCache.delete(ownerObject)
+	}
+
+	var errsLock sync.Mutex
+	errs := []error{}
+	addErr := func(err error) {
+		if err == nil {
+			return
+		}
+
+		errsLock.Lock()
+		defer errsLock.Unlock()
+		errs = append(errs, err)
+	}
+
+	deleteBackupPod := func() {
+		if e.podDisruptionBudget && e.isCleanUpOwner(ownerObject, "pod disruption budget", func() (metav1.Object, error) {
+			return e.kubeClient.PolicyV1().PodDisruptionBudgets(exposeNamespace).Get(ctx, backupPodName, metav1.GetOptions{})
+		}) && e.waitCleanUpRate(ctx, "pod disruption budget") {
+			addErr(e.deleteBackupPodDisruptionBudget(ctx, exposeNamespace, backupPodName))
+		}
+
+		if e.isCleanUpOwner(ownerObject, "pod", func() (metav1.Object, error) {
+			return e.kubeClient.CoreV1().Pods(exposeNamespace).Get(ctx, backupPodName, metav1.GetOptions{})
+		}) && e.waitCleanUpRate(ctx, "backup pod") {
+			if e.cleanUpWaitPodDeletion {
+				if err := kube.EnsureDeletePod(ctx, e.kubeClient.CoreV1(), backupPodName, exposeNamespace, e.getCleanUpTimeout()); err != nil && !apierrors.IsNotFound(err) {
+					addErr(errors.Wrapf(err, "error to wait backup pod %s/%s deleted", exposeNamespace, backupPodName))
+				}
+			} else if err := e.kubeClient.CoreV1().Pods(exposeNamespace).Delete(ctx, backupPodName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				addErr(errors.Wrapf(err, "error to delete backup pod %s/%s", exposeNamespace, backupPodName))
+			}
+		}
+
+		if e.workDirBasePath != "" {
+			addErr(e.removeWorkDir(ownerObject))
+		}
+	}
+
+	// The backupPVC should be deleted before backupVS, otherwise, the deletion of backupVS will fail since
+	// backupPVC has its dataSource referring to it
+	deleteBackupVolume := func() {
+		if e.isCleanUpOwner(ownerObject, "PVC", func() (metav1.Object, error) {
+			return e.kubeClient.CoreV1().PersistentVolumeClaims(exposeNamespace).Get(ctx, backupPVCName, metav1.GetOptions{})
+		}) {
+			if !e.waitCleanUpRate(ctx, "backup PVC") {
+				return
+			}
+
+			addErr(e.deleteBackupPVAndPVC(ctx, exposeNamespace, backupPVCName, e.getCleanUpTimeout(), e.log))
+		}
+
+		if e.isCleanUpOwner(ownerObject, "VS", func() (metav1.Object, error) {
+			return e.csiSnapshotClient.VolumeSnapshots(exposeNamespace).Get(ctx, backupVSName, metav1.GetOptions{})
+		}) {
+			if !e.waitCleanUpRate(ctx, "backup VS") {
+				return
+			}
+
+			// The finalizer may be left if GetExposed is never called, it is removed regardless of the option,
+			// so that the backup VSC is deleted along with the backup VS
+			if err := e.removeExposeFinalizer(ctx, backupVSCName); err != nil {
+				addErr(errors.Wrapf(err, "error to remove finalizer from backup VSC %s", backupVSCName))
+			}
+
+			if err := e.csiSnapshotClient.VolumeSnapshots(exposeNamespace).Delete(ctx, backupVSName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				addErr(errors.Wrapf(err, "error to delete backup VS %s/%s", exposeNamespace, backupVSName))
+			}
+		}
+
+		if e.isCleanUpOwner(ownerObject, "VSC", func() (metav1.Object, error) {
+			return e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, backupVSCName, metav1.GetOptions{})
+		}) {
+			addErr(e.deleteStaticBackupVSC(ctx, backupVSCName))
+		}
+	}
+
+	deleteSourceVS := func() {
+		if vsName == "" {
+			return
+		}
+
+		if e.waitCleanUpRate(ctx, "source VS") {
+			if err := e.csiSnapshotClient.VolumeSnapshots(sourceNamespace).Delete(ctx, vsName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				addErr(errors.Wrapf(err, "error to delete source VS %s/%s", sourceNamespace, vsName))
+			}
+		}
+	}
+
+	if e.cleanUpConcurrently {
+		wg := new(sync.WaitGroup)
+		for _, del := range []func(){deleteBackupPod, deleteBackupVolume, deleteSourceVS} {
+			wg.Add(1)
+			go func(del func()) {
+				defer wg.Done()
+				del()
+			}(del)
+		}
+
+		wg.Wait()
+	} else {
+		deleteBackupPod()
+		deleteBackupVolume()
+		deleteSourceVS()
+	}
+
+	cleanUpErr := kerrors.NewAggregate(errs)
+	if cleanUpErr != nil {
+		e.log.WithError(cleanUpErr).Warnf("Failed to clean up the expose of owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+	}
+
+	e.recordCleanUpSummary(ctx, ownerObject, exposeNamespace, vsName, sourceNamespace, cleanUpErr)
+	e.exposeNamespaces.Delete(ownerObject.UID)
+
+	if e.ownerClient != nil {
+		if err := RemoveExposeFinalizer(ctx, e.ownerClient, ownerObject); err != nil {
+			e.log.WithError(err).Warnf("Failed to remove expose finalizer from owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+		}
+	}
+}
+
+// waitCleanUpRate waits the cleanup rate limiter to allow deleting the object, it returns false if the context is done before that
+func (e *csiSnapshotExposer) waitCleanUpRate(ctx context.Context, object string) bool {
+	if err := e.cleanUpRateLimiter.wait(ctx); err != nil {
+		e.log.WithError(err).Warnf("Skip deleting %s as cleanup is canceled", object)
+		return false
+	}
+
+	return true
+}
+
+// isCleanUpOwner checks the object to be deleted by CleanUp belongs to the owner, by its controller reference or exposeOwnerUIDLabel,
+// so that the objects of another owner with the same name, e.g., a recreated DataUpload, are not deleted by mistake.
+// The object is taken as the owner's if it doesn't exist, has neither of the two, or the owner has no UID. Otherwise, a warning is logged
+func (e *csiSnapshotExposer) isCleanUpOwner(ownerObject corev1api.ObjectReference, kind string, get func() (metav1.Object, error)) bool {
+	if ownerObject.UID == "" {
+		return true
+	}
+
+	obj, err := get()
+	if err != nil {
+		return true
+	}
+
+	ownerUID := ""
+	if ref := metav1.GetControllerOf(obj); ref != nil {
+		ownerUID = string(ref.UID)
+	} else if uid, found := obj.GetLabels()[exposeOwnerUIDLabel]; found {
+		ownerUID = uid
+	}
+
+	if ownerUID == "" || ownerUID == string(ownerObject.UID) {
+		return true
+	}
+
+	e.log.Warnf("Skip deleting backup %s %s, it belongs to owner UID %s other than %s", kind, path.Join(obj.GetNamespace(), obj.GetName()), ownerUID, ownerObject.UID)
+
+	return false
+}
+
+// recordCleanUpSummary checks the objects of the expose in exposeNamespace after CleanUp and records an event on the owner summarizing the deleted ones,
+// the ones still present, e.g., blocked by finalizers, and the errors of CleanUp if any, so that the result is visible in kubectl describe.
+// It is skipped without event recorder
+func (e *csiSnapshotExposer) recordCleanUpSummary(ctx context.Context, ownerObject corev1api.ObjectReference, exposeNamespace string, vsName string, sourceNamespace string,
+	cleanUpErr error) {
+	if e.eventRecorder == nil {
+		return
+	}
+
+	type object struct {
+		description string
+		get         func() (metav1.Object, error)
+	}
+
+	objects := []object{
+		{"pod " + exposeNamespace + "/" + ownerObject.Name, func() (metav1.Object, error) {
+			return e.kubeClient.CoreV1().Pods(exposeNamespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+		}},
+		{"pvc " + exposeNamespace + "/" + ownerObject.Name, func() (metav1.Object, error) {
+			return e.kubeClient.CoreV1().PersistentVolumeClaims(exposeNamespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+		}},
+		{"vs " + exposeNamespace + "/" + ownerObject.Name, func() (metav1.Object, error) {
+			return e.csiSnapshotClient.VolumeSnapshots(exposeNamespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+		}},
+		{"vsc " + ownerObject.Name, func() (metav1.Object, error) {
+			return e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, ownerObject.Name, metav1.GetOptions{})
+		}},
+	}
+
+	if e.podDisruptionBudget {
+		objects = append(objects, object{"pdb " + exposeNamespace + "/" + ownerObject.Name, func() (metav1.Object, error) {
+			return e.kubeClient.PolicyV1().PodDisruptionBudgets(exposeNamespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+		}})
+	}
+
+	if vsName != "" {
+		objects = append(objects, object{"vs " + sourceNamespace + "/" + vsName, func() (metav1.Object, error) {
+			return e.csiSnapshotClient.VolumeSnapshots(sourceNamespace).Get(ctx, vsName, metav1.GetOptions{})
+		}})
+	}
+
+	deleted := []string{}
+	present := []string{}
+	for _, obj := range objects {
+		existing, err := obj.get()
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				deleted = append(deleted, obj.description)
+			} else {
+				present = append(present, obj.description+" (unknown)")
+				e.log.WithError(err).Warnf("Failed to check %s after clean up", obj.description)
+			}
+
+			continue
+		}
+
+		if existing.GetDeletionTimestamp() != nil {
+			present = append(present, obj.description+" (terminating)")
+		} else {
+			present = append(present, obj.description)
+		}
+	}
+
+	if cleanUpErr != nil {
+		e.recordEvent(ownerObject, true, EventReasonCleanedUp, "Clean up finished, deleted %v, still present %v, errors: %v", deleted, present, cleanUpErr)
+		return
+	}
+
+	e.recordEvent(ownerObject, len(present) > 0, EventReasonCleanedUp, "Clean up finished, deleted %v, still present %v", deleted, present)
+}
+
+// deleteStaticBackupVSC deletes the backup VSC created by ExposeFromSnapshotHandle or by Expose with SkipSourceSnapshotRetain, which is left
+// after the backup VS is deleted because of the Retain deletion policy. Otherwise, the backup VSC is deleted along with the backup VS, so it is skipped
+func (e *csiSnapshotExposer) deleteStaticBackupVSC(ctx context.Context, vscName string) error {
+	vsc, err := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, vscName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, "error to get backup VSC %s", vscName)
+	}
+
+	if vsc.Spec.DeletionPolicy != snapshotv1api.VolumeSnapshotContentRetain || vsc.Spec.Source.SnapshotHandle == nil {
+		return nil
+	}
+
+	if err := e.csiSnapshotClient.VolumeSnapshotContents().Delete(ctx, vscName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error to delete backup VSC %s", vscName)
+	}
+
+	return nil
+}
+
+// setBackupPVReclaimDelete sets the reclaim policy of the backup PV to Delete and records the original reclaim policy in the PV's annotation
+func (e *csiSnapshotExposer) setBackupPVReclaimDelete(ctx context.Context, pv *corev1api.PersistentVolume) error {
+	if pv.Spec.PersistentVolumeReclaimPolicy == corev1api.PersistentVolumeReclaimDelete {
+		return nil
+	}
+
+	origBytes, err := json.Marshal(pv)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling original PV")
+	}
+
+	updated := pv.DeepCopy()
+	if updated.Annotations == nil {
+		updated.Annotations = make(map[string]string)
+	}
+
+	if _, found := updated.Annotations[originalReclaimPolicyAnnotation]; !found {
+		updated.Annotations[originalReclaimPolicyAnnotation] = string(pv.Spec.PersistentVolumeReclaimPolicy)
+	}
+
+	updated.Spec.PersistentVolumeReclaimPolicy = corev1api.PersistentVolumeReclaimDelete
+
+	updatedBytes, err := json.Marshal(updated)
+	if err != nil {
+		return errors.Wrap(err, "error marshaling updated PV")
+	}
+
+	patchBytes, err := jsonpatch.CreateMergePatch(origBytes, updatedBytes)
+	if err != nil {
+		return errors.Wrap(err, "error creating json merge patch for PV")
+	}
+
+	_, err = e.kubeClient.CoreV1().PersistentVolumes().Patch(ctx, pv.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error patching PV")
+	}
+
+	return nil
+}
+
+// getSourceVolumeMode returns the mode of the source volume of the snapshot, from the source volume mode of the VSC if it is set,
+// otherwise, from the source PVC. Empty is returned if the mode can't be determined
+func (e *csiSnapshotExposer) getSourceVolumeMode(ctx context.Context, vs *snapshotv1api.VolumeSnapshot, vsc *snapshotv1api.VolumeSnapshotContent, log logrus.FieldLogger) corev1api.PersistentVolumeMode {
+	if vsc.Spec.SourceVolumeMode != nil && *vsc.Spec.SourceVolumeMode != "" {
+		return *vsc.Spec.SourceVolumeMode
+	}
+
+	if vs.Spec.Source.PersistentVolumeClaimName == nil || *vs.Spec.Source.PersistentVolumeClaimName == "" {
+		log.Warnf("Cannot get source volume mode as neither VSC %s nor VS %s/%s has it", vsc.Name, vs.Namespace, vs.Name)
+		return ""
+	}
+
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(vs.Namespace).Get(ctx, *vs.Spec.Source.PersistentVolumeClaimName, metav1.GetOptions{})
+	if err != nil {
+		log.WithError(err).Warnf("Failed to get source PVC %s/%s to get source volume mode", vs.Namespace, *vs.Spec.Source.PersistentVolumeClaimName)
+		return ""
+	}
+
+	if pvc.Spec.VolumeMode == nil {
+		return corev1api.PersistentVolumeFilesystem
+	}
+
+	return *pvc.Spec.VolumeMode
+}
+
+// validateSourceVolumeMode checks the access mode against the mode of the source volume of the snapshot, so that a snapshot of a filesystem volume
+// is not exposed as a block device and vice versa. The check is skipped if the access mode is unsupported, which fails the creation of the backupPVC,
+// or if the mode of the source volume can't be determined
+func (e *csiSnapshotExposer) validateSourceVolumeMode(ctx context.Context, vs *snapshotv1api.VolumeSnapshot, vsc *snapshotv1api.VolumeSnapshotContent, accessMode string,
+	log logrus.FieldLogger) error {
+	volumeMode, err := getVolumeModeByAccessMode(accessMode)
+	if err != nil {
+		return nil
+	}
+
+	sourceMode := e.getSourceVolumeMode(ctx, vs, vsc, log)
+	if sourceMode == "" {
+		log.Warnf("Skip validating access mode %s as the source volume mode of VS %s/%s is unknown", accessMode, vs.Namespace, vs.Name)
+		return nil
+	}
+
+	if sourceMode != volumeMode {
+		return withKind(ErrUnsupportedAccessMode, errors.Errorf("access mode %s doesn't match volume mode %s of the source volume of VS %s/%s", accessMode, sourceMode, vs.Namespace, vs.Name))
+	}
+
+	return nil
+}
+
+// detectNodeOS detects the OS of the node hosting the source volume of the snapshot.
+// It first checks the node affinity of the source PV, then the node the source PVC is attached to or selected for.
+// It falls back to Linux if the OS could not be determined.
+func (e *csiSnapshotExposer) detectNodeOS(ctx context.Context, vs *snapshotv1api.VolumeSnapshot, log logrus.FieldLogger) string {
+	if vs.Spec.Source.PersistentVolumeClaimName == nil || *vs.Spec.Source.PersistentVolumeClaimName == "" {
+		log.Warnf("Cannot detect node os as VS %s/%s doesn't have source PVC, default to linux", vs.Namespace, vs.Name)
+		return kube.NodeOSLinux
+	}
+
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(vs.Namespace).Get(ctx, *vs.Spec.Source.PersistentVolumeClaimName, metav1.GetOptions{})
+	if err != nil {
+		log.WithError(err).Warnf("Failed to get source PVC %s/%s to detect node os, default to linux", vs.Namespace, *vs.Spec.Source.PersistentVolumeClaimName)
+		return kube.NodeOSLinux
+	}
+
+	if pvc.Spec.VolumeName != "" {
+		if pv, err := e.kubeClient.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{}); err != nil {
+			log.WithError(err).Warnf("Failed to get source PV %s to detect node os", pvc.Spec.VolumeName)
+		} else if os := e.getNodeOSFromPVAffinity(ctx, pv, log); os != "" {
+			log.Infof("Detected node os %s from node affinity of PV %s", os, pv.Name)
+			return os
+		}
+	}
+
+	return kube.GetPVCAttachingNodeOS(pvc, e.kubeClient.CoreV1(), e.kubeClient.StorageV1(), log)
+}
+
+func (e *csiSnapshotExposer) getNodeOSFromPVAffinity(ctx context.Context, pv *corev1api.PersistentVolume, log logrus.FieldLogger) string {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return ""
+	}
+
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, exp := range term.MatchExpressions {
+			if exp.Operator != corev1api.NodeSelectorOpIn || len(exp.Values) != 1 {
+				continue
+			}
+
+			switch exp.Key {
+			case kube.NodeOSLabel:
+				return exp.Values[0]
+			case corev1api.LabelHostname:
+				if os, err := kube.GetNodeOS(ctx, exp.Values[0], e.kubeClient.CoreV1()); err != nil {
+					log.WithError(err).Warnf("Failed to get os from node %s", exp.Values[0])
+				} else if os != "" {
+					return os
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+func getVolumeModeByAccessMode(accessMode string) (corev1api.PersistentVolumeMode, error) {
+	switch accessMode {
+	case AccessModeFileSystem:
+		return corev1api.PersistentVolumeFilesystem, nil
+	case AccessModeBlock:
+		return corev1api.PersistentVolumeBlock, nil
+	default:
+		return "", withKind(ErrUnsupportedAccessMode, errors.Errorf("unsupported access mode %s", accessMode))
+	}
+}
+
+// backupPVCSettings is the settings of the backupPVC resolved from the backupPVC config
+type backupPVCSettings struct {
+	storageClass          string
+	readOnly              bool
+	spcNoRelabeling       bool
+	volumeAttributesClass *string
+	selector              *metav1.LabelSelector
+	annotations           map[string]string
+	minVolumeSize         resource.Quantity
+}
+
+// volumeAttributesClassGroupVersions are the API versions serving VolumeAttributesClass, from GA to alpha
+var volumeAttributesClassGroupVersions = []string{"storage.k8s.io/v1", "storage.k8s.io/v1beta1", "storage.k8s.io/v1alpha1"}
+
+// isVolumeAttributesClassSupported checks if the API server serves VolumeAttributesClass in any version, otherwise,
+// the VolumeAttributesClassName of the backupPVC is dropped or rejected by the API server.
+// The result is discovered once per exposer, unless the discovery fails, in which case it is discovered again by the next call
+func (e *csiSnapshotExposer) isVolumeAttributesClassSupported(curLog logrus.FieldLogger) bool {
+	e.vacSupportedLock.Lock()
+	defer e.vacSupportedLock.Unlock()
+
+	if e.vacSupported != nil {
+		return *e.vacSupported
+	}
+
+	supported, discovered := false, true
+	for _, groupVersion := range volumeAttributesClassGroupVersions {
+		resources, err := e.kubeClient.Discovery().ServerResourcesForGroupVersion(groupVersion)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				curLog.WithError(err).Warnf("Failed to discover VolumeAttributesClass in %s", groupVersion)
+				discovered = false
+			}
+
+			continue
+		}
+
+		for _, apiResource := range resources.APIResources {
+			if apiResource.Name == "volumeattributesclasses" {
+				supported = true
+			}
+		}
+
+		if supported {
+			break
+		}
+	}
+
+	if supported || discovered {
+		e.vacSupported = &supported
+	}
+
+	return supported
+}
+
+// blockIncompatibleRuntimeHandlers are the handlers of the runtimes known to lack the block device passthrough, e.g., gVisor
+var blockIncompatibleRuntimeHandlers = []string{"runsc", "gvisor"}
+
+// validateRuntimeClass checks the RuntimeClass of the backup pod exists. For the block access mode, a warning event is recorded
+// if the handler of the RuntimeClass is known to lack the block device passthrough, since the backup pod may fail to access the backupPVC
+func (e *csiSnapshotExposer) validateRuntimeClass(ctx context.Context, ownerObject corev1api.ObjectReference, runtimeClassName string, accessMode string,
+	curLog logrus.FieldLogger) error {
+	if runtimeClassName == "" {
+		return withKind(ErrInvalidExposeParam, errors.New("runtime class name of backup pod is empty"))
+	}
+
+	runtimeClass, err := e.kubeClient.NodeV1().RuntimeClasses().Get(ctx, runtimeClassName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return withKind(ErrInvalidExposeParam, errors.Errorf("runtime class %s for backup pod doesn't exist", runtimeClassName))
+		}
+
+		return errors.Wrapf(err, "error to get runtime class %s", runtimeClassName)
+	}
+
+	if accessMode == AccessModeBlock && slices.Contains(blockIncompatibleRuntimeHandlers, runtimeClass.Handler) {
+		curLog.Warnf("Handler %s of runtime class %s may not support block device passthrough", runtimeClass.Handler, runtimeClassName)
+		e.recordEvent(ownerObject, true, EventReasonRuntimeClassIncompatible, "Handler %s of runtime class %s may not support block device passthrough required by access mode %s",
+			runtimeClass.Handler, runtimeClassName, accessMode)
+	}
+
+	return nil
+}
+
+// prepareExpose resolves the backupPVC settings, validates the expose param and runs the preflight checks before exposing the snapshot
+func (e *csiSnapshotExposer) prepareExpose(ctx context.Context, ownerObject corev1api.ObjectReference, param *CSISnapshotExposeParam, curLog logrus.FieldLogger) (*backupPVCSettings, error) {
+	settings, err := e.validateExpose(ctx, ownerObject, param, curLog)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.checkLeftoverBackupObjects(ctx, ownerObject, getExposeNamespace(ownerObject, param.TargetNamespace), curLog); err != nil {
+		return nil, err
+	}
+
+	if e.ownerClient != nil {
+		if err := AddExposeFinalizer(ctx, e.ownerClient, ownerObject); err != nil {
+			return nil, errors.Wrap(err, "error to add expose finalizer to owner")
+		}
+	}
+
+	if e.snapshotController != nil {
+		if err := e.checkSnapshotController(ctx, curLog); err != nil {
+			return nil, err
+		}
+	}
+
+	return settings, nil
+}
+
+// isExposed checks whether the backup VS, PVC and pod of the owner all exist and none of them is being deleted, i.e., a previous Expose of
+// the same owner has completed, e.g., by the previous controller leader before the failover, so that Expose is a no-op instead of
+// failing on the existing objects and deleting them in the failure path
+func (e *csiSnapshotExposer) isExposed(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string) bool {
+	if ownerObject.UID == "" {
+		return false
+	}
+
+	vs, err := e.csiSnapshotClient.VolumeSnapshots(namespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err != nil || vs.DeletionTimestamp != nil || !isExposeOwnedBy(vs, ownerObject) {
+		return false
+	}
+
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err != nil || pvc.DeletionTimestamp != nil || !isExposeOwnedBy(pvc, ownerObject) {
+		return false
+	}
+
+	pod, err := e.kubeClient.CoreV1().Pods(namespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err != nil || pod.DeletionTimestamp != nil || !isExposeOwnedBy(pod, ownerObject) || isPodTerminated(pod) {
+		return false
+	}
+
+	return true
+}
+
+// isPodTerminated checks if the pod has completed or failed, so it won't run again
+func isPodTerminated(pod *corev1api.Pod) bool {
+	return pod.Status.Phase == corev1api.PodSucceeded || pod.Status.Phase == corev1api.PodFailed
+}
+
+// deleteTerminatedBackupPod deletes the backup pod of the owner left by a previous expose if it has terminated, so that a retry reusing
+// the backupPVC recreates the pod instead of keeping the terminated one as exposed.
+// It is called after prepareExpose, so the backup pod of another owner has failed the expose in checkLeftoverBackupObjects
+func (e *csiSnapshotExposer) deleteTerminatedBackupPod(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string, timeout time.Duration,
+	curLog logrus.FieldLogger) error {
+	pod, err := e.kubeClient.CoreV1().Pods(namespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, "error to get backup pod %s", ownerObject.Name)
+	}
+
+	if !isExposeOwnedBy(pod, ownerObject) || !isPodTerminated(pod) {
+		return nil
+	}
+
+	curLog.Infof("Backup pod %s/%s left by a previous expose is %s, delete it to recreate", pod.Namespace, pod.Name, pod.Status.Phase)
+
+	if err := kube.EnsureDeletePod(ctx, e.kubeClient.CoreV1(), pod.Name, pod.Namespace, timeout); err != nil && !apierrors.IsNotFound(err) {
+		return withKind(ErrBackupPodCreateFailed, errors.Wrapf(err, "error to delete terminated backup pod %s", pod.Name))
+	}
+
+	return nil
+}
+
+// checkLeftoverBackupObjects checks the backup PVC and pod left by a previous expose, e.g., whose deferred cleanup didn't complete.
+// The ones controlled by the owner are adopted when they are created.
+// The ones controlled by another owner, e.g., a previous owner with the same name, fail the expose with ErrExposeConflict,
+// so that they are not taken or deleted by mistake
+func (e *csiSnapshotExposer) checkLeftoverBackupObjects(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string,
+	curLog logrus.FieldLogger) error {
+	checkOwner := func(kind string, obj metav1.Object) error {
+		if isExposeOwnedBy(obj, ownerObject) {
+			curLog.Infof("Backup %s %s/%s is left by a previous expose of the same owner", kind, obj.GetNamespace(), obj.GetName())
+			return nil
+		}
+
+		ownerUID := "none"
+		if ref := metav1.GetControllerOf(obj); ref != nil {
+			ownerUID = string(ref.UID)
+		}
+
+		return withKind(ErrExposeConflict, errors.Errorf("backup %s %s/%s already exists and is controlled by owner UID %s other than %s, delete it to retry",
+			kind, obj.GetNamespace(), obj.GetName(), ownerUID, ownerObject.UID))
+	}
+
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err == nil {
+		if err := checkOwner("pvc", pvc); err != nil {
+			return err
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error to get backup pvc %s", ownerObject.Name)
+	}
+
+	pod, err := e.kubeClient.CoreV1().Pods(namespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err == nil {
+		if err := checkOwner("pod", pod); err != nil {
+			return err
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error to get backup pod %s", ownerObject.Name)
+	}
+
+	return nil
+}
+
+// validateExpose resolves the backupPVC settings and validates the expose param, it doesn't change any object
+func (e *csiSnapshotExposer) validateExpose(ctx context.Context, ownerObject corev1api.ObjectReference, param *CSISnapshotExposeParam, curLog logrus.FieldLogger) (*backupPVCSettings, error) {
+	backupPVCConfig := param.BackupPVCConfig
+	if e.backupPVCConfigLoader != nil {
+		fromConfigMap, err := e.backupPVCConfigLoader.load(ctx, e.kubeClient)
+		if err != nil {
+			return nil, withKind(ErrInvalidExposeParam, errors.Wrap(err, "error to load backupPVC config"))
+		}
+
+		backupPVCConfig = mergeBackupPVCConfig(fromConfigMap, backupPVCConfig)
+	}
+
+	// check if there is a mapping for source pvc storage class in backupPVC config
+	// if the mapping exists then use the values(storage class, readOnly accessMode)
+	// for backupPVC (intermediate PVC in snapshot data movement) object creation
+	backupPVCStorageClass := param.StorageClass
+	backupPVCReadOnly := false
+	spcNoRelabeling := false
+	var volumeAttributesClass *string
+	var minVolumeSize resource.Quantity
+	if value, exists := backupPVCConfig[param.StorageClass]; exists {
+		if value.StorageClass != "" {
+			backupPVCStorageClass = value.StorageClass
+		}
+
+		if value.BackupPVCVolumeAttributesClass != nil {
+			if e.isVolumeAttributesClassSupported(curLog) {
+				volumeAttributesClass = value.BackupPVCVolumeAttributesClass
+			} else {
+				curLog.WithField("vs name", param.SnapshotName).Warnf("Ignoring volume attributes class %s, which is not supported by the API server", *value.BackupPVCVolumeAttributesClass)
+			}
+		}
+
+		if value.MinVolumeSize.Sign() < 0 {
+			return nil, withKind(ErrInvalidExposeParam, errors.Errorf("invalid min volume size %s of storage class %s", formatQuantity(value.MinVolumeSize), param.StorageClass))
+		}
+
+		minVolumeSize = value.MinVolumeSize
+
+		backupPVCReadOnly = value.ReadOnly
+		if value.SPCNoRelabeling {
+			if backupPVCReadOnly {
+				spcNoRelabeling = true
 			} else {
-				diag += kube.DiagnosePV(pv)
+				curLog.WithField("vs name", param.SnapshotName).Warn("Ignoring spcNoRelabling for read-write volume")
+			}
+		}
+	}
+
+	if param.RunAsNonRoot && param.NodeOS != kube.NodeOSWindows {
+		if spcNoRelabeling {
+			return nil, withKind(ErrInvalidExposeParam, errors.New("spcNoRelabeling is not compatible with runAsNonRoot"))
+		}
+
+		if param.RunAsUserID != nil && *param.RunAsUserID == 0 {
+			return nil, withKind(ErrInvalidExposeParam, errors.New("runAsNonRoot is requested with root user ID"))
+		}
+	}
+
+	if request, found := param.Resources.Requests[corev1api.ResourceEphemeralStorage]; found {
+		if limit, found := param.Resources.Limits[corev1api.ResourceEphemeralStorage]; found && request.Cmp(limit) > 0 {
+			return nil, withKind(ErrInvalidExposeParam, errors.Errorf("ephemeral-storage request %s is larger than limit %s", request.String(), limit.String()))
+		}
+	}
+
+	switch param.PodRestartPolicy {
+	case "", corev1api.RestartPolicyNever, corev1api.RestartPolicyOnFailure:
+	default:
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("unsupported restart policy %s of backup pod", param.PodRestartPolicy))
+	}
+
+	switch param.ImagePullPolicy {
+	case "", corev1api.PullAlways, corev1api.PullIfNotPresent, corev1api.PullNever:
+	default:
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("unsupported image pull policy %s of backup pod", param.ImagePullPolicy))
+	}
+
+	if param.PodRestartLimit < 0 {
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("invalid restart limit %d of backup pod", param.PodRestartLimit))
+	}
+
+	if param.PodTerminationGracePeriod != nil && *param.PodTerminationGracePeriod < 0 {
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("invalid termination grace period %d of backup pod", *param.PodTerminationGracePeriod))
+	}
+
+	if err := validateTopologySpread(param.TopologySpread, param.HostingPodLabels); err != nil {
+		return nil, withKind(ErrInvalidExposeParam, err)
+	}
+
+	if err := validateSeccompProfile(param.SeccompProfile); err != nil {
+		return nil, withKind(ErrInvalidExposeParam, err)
+	}
+
+	if err := validateHostAliases(param.HostAliases); err != nil {
+		return nil, withKind(ErrInvalidExposeParam, err)
+	}
+
+	if err := validateFSGroup(param.FSGroup, param.FSGroupChangePolicy); err != nil {
+		return nil, withKind(ErrInvalidExposeParam, err)
+	}
+
+	if param.VolumeSizeOverhead.Sign() < 0 {
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("invalid volume size overhead %s", formatQuantity(param.VolumeSizeOverhead)))
+	}
+
+	if param.VolumeSizeMismatchTolerance < 0 {
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("invalid volume size mismatch tolerance %d", param.VolumeSizeMismatchTolerance))
+	}
+
+	switch param.NodeAgentAffinity {
+	case "", NodeAgentAffinityOff, NodeAgentAffinityRequired, NodeAgentAffinityPreferred:
+	default:
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("unsupported node-agent affinity mode %s", param.NodeAgentAffinity))
+	}
+
+	switch param.Direction {
+	case "", ExposeDirectionBackup, ExposeDirectionRestore:
+	default:
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("unsupported expose direction %s", param.Direction))
+	}
+
+	if e.podDisruptionBudget && param.PodActiveDeadline <= 0 {
+		return nil, withKind(ErrInvalidExposeParam, errors.New("pod disruption budget requires an active deadline of the backup pod"))
+	}
+
+	exposeName := getExposeName(ownerObject, param.NameSource)
+	if exposeName == "" {
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("owner %s/%s has no UID and no name source is specified to name the backup container and volume",
+			ownerObject.Namespace, ownerObject.Name))
+	}
+
+	if errs := validation.IsDNS1123Label(exposeName); len(errs) > 0 {
+		return nil, withKind(ErrInvalidExposeParam, errors.Errorf("invalid name %s for the backup container and volume: %s", exposeName, strings.Join(errs, ", ")))
+	}
+
+	if err := validateExtraVolumes(param.ExtraVolumes, param.ExtraVolumeMounts, exposeName); err != nil {
+		return nil, withKind(ErrInvalidExposeParam, err)
+	}
+
+	if param.MountWorkDir {
+		if e.workDirBasePath == "" {
+			return nil, withKind(ErrInvalidExposeParam, errors.New("working directory on the node is not allowed by the exposer"))
+		}
+
+		if err := validateWorkDirBasePath(e.workDirBasePath); err != nil {
+			return nil, withKind(ErrInvalidExposeParam, err)
+		}
+	}
+
+	if param.RuntimeClassName != nil {
+		if err := e.validateRuntimeClass(ctx, ownerObject, *param.RuntimeClassName, param.AccessMode, curLog); err != nil {
+			return nil, err
+		}
+	}
+
+	if param.TargetNamespace != "" {
+		if _, err := e.kubeClient.CoreV1().Namespaces().Get(ctx, param.TargetNamespace, metav1.GetOptions{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, withKind(ErrInvalidExposeParam, errors.Errorf("target namespace %s doesn't exist", param.TargetNamespace))
+			}
+
+			return nil, errors.Wrapf(err, "error to get target namespace %s", param.TargetNamespace)
+		}
+	}
+
+	if param.ReadinessProbe != nil {
+		handler := param.ReadinessProbe.ProbeHandler
+		if handler.Exec == nil && handler.HTTPGet == nil && handler.TCPSocket == nil && handler.GRPC == nil {
+			return nil, withKind(ErrInvalidExposeParam, errors.New("readiness probe of backup pod has no handler"))
+		}
+	}
+
+	if param.SourcePVCSelector != nil {
+		if err := e.validateSourcePVCSelector(ctx, param, backupPVCStorageClass); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, vsClass := range []string{param.BackupVolumeSnapshotClass, param.DefaultVolumeSnapshotClass} {
+		if vsClass == "" {
+			continue
+		}
+
+		if _, err := e.csiSnapshotClient.VolumeSnapshotClasses().Get(ctx, vsClass, metav1.GetOptions{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, withKind(ErrInvalidExposeParam, withKind(ErrSnapshotClassNotFound, errors.Errorf("volume snapshot class %s for backup VS doesn't exist", vsClass)))
+			}
+
+			return nil, errors.Wrapf(err, "error to get volume snapshot class %s", vsClass)
+		}
+	}
+
+	return &backupPVCSettings{
+		storageClass:          backupPVCStorageClass,
+		readOnly:              backupPVCReadOnly,
+		spcNoRelabeling:       spcNoRelabeling,
+		volumeAttributesClass: volumeAttributesClass,
+		selector:              param.SourcePVCSelector,
+		minVolumeSize:         minVolumeSize,
+	}, nil
+}
+
+// validateSourcePVCSelector checks the selector of the source PVC could be mirrored onto the backupPVC, i.e., the backupPVC is bound to
+// the static backup PV matching the selector instead of provisioned from the backup VS, and the storage class binds volumes immediately
+func (e *csiSnapshotExposer) validateSourcePVCSelector(ctx context.Context, param *CSISnapshotExposeParam, storageClass string) error {
+	if param.StaticBackupPVName == "" {
+		return withKind(ErrInvalidExposeParam, errors.New("selector of source PVC conflicts with the data source of backup PVC, it requires the static backup PV"))
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(param.SourcePVCSelector)
+	if err != nil {
+		return withKind(ErrInvalidExposeParam, errors.Wrap(err, "invalid selector of source PVC"))
+	}
+
+	if !selector.Matches(labels.Set(param.SourcePVCSelector.MatchLabels)) {
+		return withKind(ErrInvalidExposeParam, errors.Errorf("selector %s of source PVC doesn't match the static backup PV %s", selector.String(), param.StaticBackupPVName))
+	}
+
+	if storageClass == "" {
+		return nil
+	}
+
+	sc, err := e.kubeClient.StorageV1().StorageClasses().Get(ctx, storageClass, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return withKind(ErrInvalidExposeParam, errors.Errorf("storage class %s for backup PVC doesn't exist", storageClass))
+		}
+
+		return errors.Wrapf(err, "error to get storage class %s", storageClass)
+	}
+
+	if sc.VolumeBindingMode != nil && *sc.VolumeBindingMode != storagev1api.VolumeBindingImmediate {
+		return withKind(ErrInvalidExposeParam, errors.Errorf("selector of source PVC requires the Immediate volume binding mode, storage class %s has %s", storageClass, *sc.VolumeBindingMode))
+	}
+
+	return nil
+}
+
+// validateSeccompProfile checks the localhost profile is set if and only if the profile type is Localhost, as the API server requires
+func validateSeccompProfile(profile *corev1api.SeccompProfile) error {
+	if profile == nil {
+		return nil
+	}
+
+	switch profile.Type {
+	case corev1api.SeccompProfileTypeLocalhost:
+		if profile.LocalhostProfile == nil || *profile.LocalhostProfile == "" {
+			return errors.New("localhost profile is required by seccomp profile type Localhost")
+		}
+	case corev1api.SeccompProfileTypeRuntimeDefault, corev1api.SeccompProfileTypeUnconfined:
+		if profile.LocalhostProfile != nil {
+			return errors.Errorf("localhost profile is not allowed by seccomp profile type %s", profile.Type)
+		}
+	default:
+		return errors.Errorf("unsupported seccomp profile type %s", profile.Type)
+	}
+
+	return nil
+}
+
+// validateHostAliases checks each host alias has a valid IP and at least one hostname, as the API server requires
+func validateHostAliases(aliases []corev1api.HostAlias) error {
+	for _, alias := range aliases {
+		if net.ParseIP(alias.IP) == nil {
+			return errors.Errorf("invalid IP %q of host alias", alias.IP)
+		}
+
+		if len(alias.Hostnames) == 0 {
+			return errors.Errorf("no hostname in host alias of IP %s", alias.IP)
+		}
+	}
+
+	return nil
+}
+
+// validateFSGroup checks the fsGroup is not negative and the fsGroup change policy is supported and set along with the fsGroup
+func validateFSGroup(fsGroup *int64, changePolicy *corev1api.PodFSGroupChangePolicy) error {
+	if fsGroup != nil && *fsGroup < 0 {
+		return errors.Errorf("invalid fsGroup %d of backup pod", *fsGroup)
+	}
+
+	if changePolicy == nil {
+		return nil
+	}
+
+	switch *changePolicy {
+	case corev1api.FSGroupChangeAlways, corev1api.FSGroupChangeOnRootMismatch:
+	default:
+		return errors.Errorf("unsupported fsGroup change policy %s of backup pod", *changePolicy)
+	}
+
+	if fsGroup == nil {
+		return errors.New("fsGroup change policy is specified without fsGroup")
+	}
+
+	return nil
+}
+
+// validateTopologySpread checks each topology spread constraint selects the backup pods by the exposer pod group label,
+// i.e., it matches the labels of the backup pod but not the same labels without the pod group label
+func validateTopologySpread(constraints []corev1api.TopologySpreadConstraint, hostingPodLabels map[string]string) error {
+	ungroupedLabels := labels.Set{}
+	for k, v := range hostingPodLabels {
+		if k != podGroupLabel {
+			ungroupedLabels[k] = v
+		}
+	}
+
+	podLabels := labels.Merge(ungroupedLabels, labels.Set{podGroupLabel: podGroupSnapshot})
+
+	for i, constraint := range constraints {
+		if constraint.LabelSelector == nil {
+			return errors.Errorf("topology spread constraint %d has no label selector", i)
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(constraint.LabelSelector)
+		if err != nil {
+			return errors.Wrapf(err, "error to parse label selector of topology spread constraint %d", i)
+		}
+
+		if !selector.Matches(podLabels) || selector.Matches(ungroupedLabels) {
+			return errors.Errorf("topology spread constraint %d doesn't select backup pods by label %s=%s", i, podGroupLabel, podGroupSnapshot)
+		}
+	}
+
+	return nil
+}
+
+// getExposeName returns the name of the backup container and the backup volume, which is the owner UID unless it is overridden by nameSource
+func getExposeName(ownerObject corev1api.ObjectReference, nameSource string) string {
+	if nameSource != "" {
+		return nameSource
+	}
+
+	return string(ownerObject.UID)
+}
+
+// getBackupContainerName returns the name of the backup container in the backup pod, which is named by the owner UID
+// unless the name is overridden in the expose, in which case the backup container is the first container
+func getBackupContainerName(pod *corev1api.Pod, ownerObject corev1api.ObjectReference) string {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == string(ownerObject.UID) {
+			return container.Name
+		}
+	}
+
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+
+	return string(ownerObject.UID)
+}
+
+// getExposedBackupVolumes returns the backup volumes in the backup pod, the first of which is the one named volumeName and mounted by containerName.
+// The other backup volumes, bundled in the same pod, are named with volumeName and a "-" suffix, and each is mounted by the first container mounting it.
+// It returns nil if the volume named volumeName doesn't exist
+func getExposedBackupVolumes(pod *corev1api.Pod, volumeName string, containerName string) []ExposeVolume {
+	var volumes []ExposeVolume
+	found := false
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == volumeName {
+			found = true
+		} else if strings.HasPrefix(volume.Name, volumeName+"-") {
+			volumes = append(volumes, ExposeVolume{
+				VolumeName:    volume.Name,
+				ContainerName: getVolumeContainerName(pod, volume.Name, containerName),
+			})
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return append([]ExposeVolume{{VolumeName: volumeName, ContainerName: containerName}}, volumes...)
+}
+
+// getVolumeContainerName returns the name of the first container mounting the volume, or defaultName if there is none
+func getVolumeContainerName(pod *corev1api.Pod, volumeName string, defaultName string) string {
+	for _, container := range pod.Spec.Containers {
+		for _, mount := range container.VolumeMounts {
+			if mount.Name == volumeName {
+				return container.Name
+			}
+		}
+
+		for _, device := range container.VolumeDevices {
+			if device.Name == volumeName {
+				return container.Name
+			}
+		}
+	}
+
+	return defaultName
+}
+
+// hasReadinessProbe checks if the data mover container of the backup pod has a readiness probe
+func hasReadinessProbe(pod *corev1api.Pod, containerName string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == containerName {
+			return container.ReadinessProbe != nil
+		}
+	}
+
+	return false
+}
+
+// waitBackupPodReady waits the backup pod ready, i.e., the readiness probe of the data mover container succeeds.
+// It fails immediately if the pod terminates before that
+func (e *csiSnapshotExposer) waitBackupPodReady(ctx context.Context, pod *corev1api.Pod, timeout time.Duration) (*corev1api.Pod, error) {
+	var readyPod *corev1api.Pod
+	err := wait.PollUntilContextTimeout(ctx, backupPodReadyPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		current, err := e.kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "error to get backup pod %s", pod.Name)
+		}
+
+		if current.Status.Phase == corev1api.PodFailed || current.Status.Phase == corev1api.PodSucceeded {
+			return false, errors.Errorf("backup pod %s terminated in phase %s before it is ready", pod.Name, current.Status.Phase)
+		}
+
+		for _, condition := range current.Status.Conditions {
+			if condition.Type == corev1api.PodReady && condition.Status == corev1api.ConditionTrue {
+				readyPod = current
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, errors.Errorf("timeout to wait backup pod %s ready", pod.Name)
+		}
+
+		return nil, err
+	}
+
+	return readyPod, nil
+}
+
+// validateExtraVolumes checks the extra volumes and mounts don't collide with the backup volume, whose mount or device path is "/<volumeName>"
+func validateExtraVolumes(extraVolumes []corev1api.Volume, extraMounts []corev1api.VolumeMount, volumeName string) error {
+	backupVolumePath := "/" + volumeName
+
+	for _, volume := range extraVolumes {
+		if volume.Name == volumeName {
+			return errors.Errorf("extra volume %s conflicts with the backup volume", volume.Name)
+		}
+	}
+
+	for _, mount := range extraMounts {
+		if mount.Name == volumeName {
+			return errors.Errorf("extra volume mount %s conflicts with the backup volume", mount.Name)
+		}
+
+		mountPath := path.Clean(mount.MountPath)
+		if mountPath == backupVolumePath || strings.HasPrefix(mountPath, backupVolumePath+"/") || strings.HasPrefix(backupVolumePath, strings.TrimSuffix(mountPath, "/")+"/") {
+			return errors.Errorf("mount path %s of extra volume %s conflicts with the backup volume path %s", mount.MountPath, mount.Name, backupVolumePath)
+		}
+	}
+
+	return nil
+}
+
+// exposeBackupVolume creates the backupPVC from the backup VS, or bound to the static backup PV created from the backup VSC,
+// and the backup pod mounting the backupPVC
+func (e *csiSnapshotExposer) exposeBackupVolume(ctx context.Context, ownerObject corev1api.ObjectReference, param *CSISnapshotExposeParam, settings *backupPVCSettings,
+	backupVS string, backupVSC *snapshotv1api.VolumeSnapshotContent, volumeSize resource.Quantity, nodeOS string, extraEnv []corev1api.EnvVar, curLog logrus.FieldLogger) (err error) {
+	exposeNamespace := getExposeNamespace(ownerObject, param.TargetNamespace)
+
+	if param.StaticBackupPVName != "" {
+		backupPV, err := e.createStaticBackupPV(ctx, ownerObject, exposeNamespace, param.StaticBackupPVName, backupVSC, settings, param.AccessMode, volumeSize)
+		if err != nil {
+			return withKind(ErrBackupPVCCreateFailed, errors.Wrap(err, "error to create static backup pv"))
+		}
+
+		curLog.WithField("pv name", backupPV.Name).Infof("Static backup PV is created from VSC %s", backupVSC.Name)
+	}
+
+	backupPVC, pvcAdopted, err := e.createBackupPVC(ctx, ownerObject, exposeNamespace, backupVS, settings.storageClass, param.AccessMode, volumeSize, settings.readOnly, getPVCCreateBackoff(param), param.StaticBackupPVName,
+		settings.volumeAttributesClass, settings.selector, settings.annotations)
+	if err != nil {
+		if param.StaticBackupPVName != "" {
+			cleanUpCtx, cancel := newCleanUpContext(ctx)
+			defer cancel()
+
+			kube.DeletePVIfAny(cleanUpCtx, e.kubeClient.CoreV1(), param.StaticBackupPVName, curLog)
+		}
+
+		return withKind(ErrBackupPVCCreateFailed, errors.Wrap(err, "error to create backup pvc"))
+	}
+
+	curLog = curLog.WithField("backupPVC", backupPVC.Name)
+	curLog.Info("Backup PVC is created")
+	defer func() {
+		if err != nil && !pvcAdopted {
+			cleanUpCtx, cancel := newCleanUpContext(ctx)
+			defer cancel()
+
+			if err := e.deleteBackupPVAndPVC(cleanUpCtx, backupPVC.Namespace, backupPVC.Name, 0, curLog); err != nil {
+				curLog.WithError(err).Warn("Failed to delete backup PVC")
 			}
 		}
+	}()
+
+	return e.exposeBackupPod(ctx, ownerObject, param, settings, backupPVC, backupVSC.Spec.Driver, nodeOS, extraEnv, curLog)
+}
+
+// getReusableBackupPVC returns the backupPVC and backup VS left by a previous expose of the owner if the backupPVC could be reused,
+// i.e., both are owned by the owner and not being deleted, the backupPVC is bound and its data source is the backup VS. Otherwise, nil is returned
+func (e *csiSnapshotExposer) getReusableBackupPVC(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string,
+	curLog logrus.FieldLogger) (*corev1api.PersistentVolumeClaim, *snapshotv1api.VolumeSnapshot) {
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			curLog.WithError(err).Warnf("Failed to get backup pvc %s/%s to reuse", namespace, ownerObject.Name)
+		}
+
+		return nil, nil
+	}
+
+	if pvc.DeletionTimestamp != nil || !isExposeOwnedBy(pvc, ownerObject) || pvc.Status.Phase != corev1api.ClaimBound {
+		curLog.Infof("Backup pvc %s/%s is not reusable as it is not bound or not owned by the owner", namespace, pvc.Name)
+		return nil, nil
+	}
+
+	dataSource := pvc.Spec.DataSource
+	if dataSource == nil || dataSource.Kind != "VolumeSnapshot" || dataSource.APIGroup == nil || *dataSource.APIGroup != snapshotv1api.SchemeGroupVersion.Group ||
+		dataSource.Name != ownerObject.Name {
+		curLog.Warnf("Backup pvc %s/%s is not reusable as its data source is not the backup VS %s", namespace, pvc.Name, ownerObject.Name)
+		return nil, nil
+	}
+
+	vs, err := e.csiSnapshotClient.VolumeSnapshots(namespace).Get(ctx, dataSource.Name, metav1.GetOptions{})
+	if err != nil || vs.DeletionTimestamp != nil || !isExposeOwnedBy(vs, ownerObject) {
+		curLog.WithError(err).Warnf("Backup pvc %s/%s is not reusable as its backup VS %s is not available", namespace, pvc.Name, dataSource.Name)
+		return nil, nil
+	}
+
+	return pvc, vs
+}
+
+// exposeReusedBackupPVC exposes the snapshot by the backupPVC left by a previous expose of the owner, only the backup pod is created
+func (e *csiSnapshotExposer) exposeReusedBackupPVC(ctx context.Context, ownerObject corev1api.ObjectReference, param *CSISnapshotExposeParam, settings *backupPVCSettings,
+	backupPVC *corev1api.PersistentVolumeClaim, backupVS *snapshotv1api.VolumeSnapshot, curLog logrus.FieldLogger) error {
+	curLog = curLog.WithField("backupVS", backupVS.Name).WithField("backupPVC", backupPVC.Name)
+	curLog.Info("Reuse bound backup PVC")
+
+	driver := ""
+	backupVSC, err := csi.GetVolumeSnapshotContentForVolumeSnapshot(backupVS, e.csiSnapshotClient)
+	if err != nil {
+		curLog.WithError(err).Warn("Failed to get backup VSC of the reused backup PVC")
+	} else {
+		driver = backupVSC.Spec.Driver
+		curLog = curLog.WithField("backupVSC", backupVSC.Name)
+	}
+
+	// the source snapshot may have been deleted, so detect the node OS from the backupPVC instead
+	nodeOS := param.NodeOS
+	if nodeOS == "" {
+		nodeOS = kube.GetPVCAttachingNodeOS(backupPVC, e.kubeClient.CoreV1(), e.kubeClient.StorageV1(), curLog)
+	}
+
+	var snapshotEnv []corev1api.EnvVar
+	if param.InjectSnapshotMetadataEnv {
+		snapshotClass := ""
+		if backupVS.Spec.VolumeSnapshotClassName != nil {
+			snapshotClass = *backupVS.Spec.VolumeSnapshotClassName
+		}
+
+		restoreSize := backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage]
+		if backupVS.Status != nil && backupVS.Status.RestoreSize != nil {
+			restoreSize = *backupVS.Status.RestoreSize
+		}
+
+		snapshotEnv = []corev1api.EnvVar{
+			{Name: EnvSnapshotDriver, Value: driver},
+			{Name: EnvSnapshotClass, Value: snapshotClass},
+			{Name: EnvRestoreSize, Value: formatQuantity(restoreSize)},
+			{Name: EnvSourceNamespace, Value: param.SourceNamespace},
+		}
 	}
 
-	if vs != nil {
-		diag += csi.DiagnoseVS(vs)
+	return e.exposeBackupPod(ctx, ownerObject, param, settings, backupPVC, driver, nodeOS, snapshotEnv, curLog)
+}
 
-		if vs.Status != nil && vs.Status.BoundVolumeSnapshotContentName != nil && *vs.Status.BoundVolumeSnapshotContentName != "" {
-			if vsc, err := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, *vs.Status.BoundVolumeSnapshotContentName, metav1.GetOptions{}); err != nil {
-				diag += fmt.Sprintf("error getting backup vsc %s, err: %v\n", *vs.Status.BoundVolumeSnapshotContentName, err)
-			} else {
-				diag += csi.DiagnoseVSC(vsc)
-			}
+// exposeBackupPod creates the backup pod mounting the backupPVC, or adopts the compatible one created by a concurrent expose of the same owner
+func (e *csiSnapshotExposer) exposeBackupPod(ctx context.Context, ownerObject corev1api.ObjectReference, param *CSISnapshotExposeParam, settings *backupPVCSettings,
+	backupPVC *corev1api.PersistentVolumeClaim, driver string, nodeOS string, extraEnv []corev1api.EnvVar, curLog logrus.FieldLogger) (err error) {
+	exposeNamespace := getExposeNamespace(ownerObject, param.TargetNamespace)
+
+	backupPod, err := e.createBackupPod(
+		ctx,
+		ownerObject,
+		backupPVC,
+		param,
+		settings.readOnly,
+		settings.spcNoRelabeling,
+		nodeOS,
+		driver,
+		extraEnv,
+	)
+	podAdopted := false
+	if err != nil && apierrors.IsAlreadyExists(err) {
+		// the backup pod may be created by a concurrent expose of the same owner after the leftover check, e.g., during the failover of the controller leader
+		existing, getErr := e.kubeClient.CoreV1().Pods(exposeNamespace).Get(ctx, ownerObject.Name, metav1.GetOptions{})
+		if getErr == nil && isBackupPodCompatible(existing, ownerObject, backupPVC.Name, getExposeName(ownerObject, param.NameSource)) {
+			curLog.WithField("backupPod", existing.Name).Info("Adopt backup pod created by a concurrent expose")
+			backupPod, podAdopted, err = existing, true, nil
 		}
 	}
 
-	diag += "end diagnose CSI exposer"
+	if err != nil {
+		return withKind(ErrBackupPodCreateFailed, errors.Wrap(err, "error to create backup pod"))
+	}
 
-	return diag
-}
+	curLog = curLog.WithField("backupPod", backupPod.Name)
+	curLog.WithField("affinity", param.Affinities).Info("Backup pod is created")
 
-const cleanUpTimeout = time.Minute
+	defer func() {
+		if err != nil && !podAdopted {
+			cleanUpCtx, cancel := newCleanUpContext(ctx)
+			defer cancel()
 
-func (e *csiSnapshotExposer) CleanUp(ctx context.Context, ownerObject corev1api.ObjectReference, vsName string, sourceNamespace string) {
-	backupPodName := ownerObject.Name
-	backupPVCName := ownerObject.Name
-	backupVSName := ownerObject.Name
+			kube.DeletePodIfAny(cleanUpCtx, e.kubeClient.CoreV1(), backupPod.Name, backupPod.Namespace, curLog)
+		}
+	}()
+
+	if e.podDisruptionBudget {
+		if err := e.createBackupPodDisruptionBudget(ctx, ownerObject, exposeNamespace, getExposeName(ownerObject, param.NameSource)); err != nil {
+			return withKind(ErrBackupPodCreateFailed, err)
+		}
 
-	kube.DeletePodIfAny(ctx, e.kubeClient.CoreV1(), backupPodName, ownerObject.Namespace, e.log)
-	kube.DeletePVAndPVCIfAny(ctx, e.kubeClient.CoreV1(), backupPVCName, ownerObject.Namespace, cleanUpTimeout, e.log)
+		curLog.WithField("pdb name", ownerObject.Name).Info("Pod disruption budget of backup pod is created")
+	}
 
-	csi.DeleteVolumeSnapshotIfAny(ctx, e.csiSnapshotClient, backupVSName, ownerObject.Namespace, e.log)
-	csi.DeleteVolumeSnapshotIfAny(ctx, e.csiSnapshotClient, vsName, sourceNamespace, e.log)
+	return nil
 }
 
-func getVolumeModeByAccessMode(accessMode string) (corev1api.PersistentVolumeMode, error) {
-	switch accessMode {
-	case AccessModeFileSystem:
-		return corev1api.PersistentVolumeFilesystem, nil
-	case AccessModeBlock:
-		return corev1api.PersistentVolumeBlock, nil
-	default:
-		return "", errors.Errorf("unsupported access mode %s", accessMode)
+// resolveBackupVolumeSnapshotClass returns the class overriding the one of the source snapshot for the backup VS and VSC, or empty to copy the class
+// from the source snapshot. If the class of the source snapshot has been deleted, DefaultVolumeSnapshotClass is used or ErrSnapshotClassNotFound is
+// returned, so that the expose fails fast instead of waiting for the backup VS to time out. The check is skipped if the class is overridden or the
+// source snapshot has no class, e.g., it is bound to a pre-provisioned VSC
+func (e *csiSnapshotExposer) resolveBackupVolumeSnapshotClass(ctx context.Context, snapshotVS *snapshotv1api.VolumeSnapshot, param *CSISnapshotExposeParam,
+	log logrus.FieldLogger) (string, error) {
+	if param.BackupVolumeSnapshotClass != "" || snapshotVS.Spec.VolumeSnapshotClassName == nil {
+		return param.BackupVolumeSnapshotClass, nil
 	}
+
+	sourceClass := *snapshotVS.Spec.VolumeSnapshotClassName
+	_, err := e.csiSnapshotClient.VolumeSnapshotClasses().Get(ctx, sourceClass, metav1.GetOptions{})
+	if err == nil {
+		return "", nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return "", errors.Wrapf(err, "error to get volume snapshot class %s", sourceClass)
+	}
+
+	if param.DefaultVolumeSnapshotClass == "" {
+		return "", withKind(ErrSnapshotClassNotFound, errors.Errorf("volume snapshot class %s of snapshot %s/%s doesn't exist, specify a default volume snapshot class to fall back",
+			sourceClass, snapshotVS.Namespace, snapshotVS.Name))
+	}
+
+	log.Warnf("Volume snapshot class %s of snapshot %s/%s doesn't exist, fall back to %s", sourceClass, snapshotVS.Namespace, snapshotVS.Name, param.DefaultVolumeSnapshotClass)
+
+	return param.DefaultVolumeSnapshotClass, nil
 }
 
-func (e *csiSnapshotExposer) createBackupVS(ctx context.Context, ownerObject corev1api.ObjectReference, snapshotVS *snapshotv1api.VolumeSnapshot) (*snapshotv1api.VolumeSnapshot, error) {
+// createBackupVS creates the backup VS bound to the backup VSC. If the backup VS of the same owner exists and is not being deleted, e.g., created by
+// a concurrent expose during the failover of the controller leader, it is adopted and true is returned, so that the caller doesn't delete it on failure
+func (e *csiSnapshotExposer) createBackupVS(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string, snapshotVS *snapshotv1api.VolumeSnapshot, labels map[string]string, vsClass string,
+	operationTimeout time.Duration) (*snapshotv1api.VolumeSnapshot, bool, error) {
 	backupVSName := ownerObject.Name
 	backupVSCName := ownerObject.Name
 
+	vsClassName := snapshotVS.Spec.VolumeSnapshotClassName
+	if vsClass != "" {
+		vsClassName = &vsClass
+	}
+
+	if duLabels := getDataUploadLabels(ownerObject); duLabels != nil {
+		labels = maps.Clone(labels)
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+
+		maps.Copy(labels, duLabels)
+	}
+
 	vs := &snapshotv1api.VolumeSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        backupVSName,
-			Namespace:   ownerObject.Namespace,
+			Namespace:   namespace,
+			Labels:      labels,
 			Annotations: snapshotVS.Annotations,
 			// Don't add ownerReference to SnapshotBackup.
 			// The backupPVC should be deleted before backupVS, otherwise, the deletion of backupVS will fail since
@@ -428,19 +3390,175 @@ func (e *csiSnapshotExposer) createBackupVS(ctx context.Context, ownerObject cor
 			Source: snapshotv1api.VolumeSnapshotSource{
 				VolumeSnapshotContentName: &backupVSCName,
 			},
-			VolumeSnapshotClassName: snapshotVS.Spec.VolumeSnapshotClassName,
+			VolumeSnapshotClassName: vsClassName,
 		},
 	}
 
-	return e.csiSnapshotClient.VolumeSnapshots(vs.Namespace).Create(ctx, vs, metav1.CreateOptions{})
+	created, err := e.csiSnapshotClient.VolumeSnapshots(vs.Namespace).Create(ctx, vs, metav1.CreateOptions{})
+	if err == nil || (!apierrors.IsAlreadyExists(err) && !apierrors.IsConflict(err)) {
+		return created, false, err
+	}
+
+	existing, getErr := e.csiSnapshotClient.VolumeSnapshots(vs.Namespace).Get(ctx, vs.Name, metav1.GetOptions{})
+	if getErr == nil && existing.DeletionTimestamp == nil && isExposeOwnedBy(existing, ownerObject) {
+		e.log.WithField("owner", ownerObject.Name).Infof("Adopt existing backup VS %s/%s", existing.Namespace, existing.Name)
+		return existing, true, nil
+	}
+
+	// A stale backup VS left by a previous expose may be still in deleting, wait it gone and recreate
+	e.log.WithField("owner", ownerObject.Name).WithError(err).Warnf("Backup VS %s conflicts, wait the stale one deleted", vs.Name)
+
+	if err := e.waitStaleBackupVSDeleted(ctx, vs.Namespace, vs.Name, operationTimeout); err != nil {
+		return nil, false, err
+	}
+
+	created, err = e.csiSnapshotClient.VolumeSnapshots(vs.Namespace).Create(ctx, vs, metav1.CreateOptions{})
+	return created, false, err
+}
+
+// reconcileSourceSnapshotChange checks the backup VSC left by a previous expose attempt against the source VSC.
+// If the backup VSC refers to a different snapshot handle, i.e., the source snapshot has been re-created, the stale backup VS and VSC
+// are deleted when rebuild is set, so that the backup chain is rebuilt from the current source snapshot; otherwise, it fails with ErrExposeConflict.
+// The stale backup VS and VSC are only deleted if they belong to the owner, otherwise, it fails with ErrExposeConflict as well
+func (e *csiSnapshotExposer) reconcileSourceSnapshotChange(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string, sourceVSC *snapshotv1api.VolumeSnapshotContent,
+	rebuild bool, timeout time.Duration, log logrus.FieldLogger) error {
+	backupVSName := ownerObject.Name
+	backupVSCName := ownerObject.Name
+
+	backupVSC, err := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, backupVSCName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return withKind(ErrBackupSnapshotCreateFailed, errors.Wrapf(err, "error to get existing backup VSC %s", backupVSCName))
+	}
+
+	backupHandle := ""
+	if backupVSC.Spec.Source.SnapshotHandle != nil {
+		backupHandle = *backupVSC.Spec.Source.SnapshotHandle
+	}
+
+	sourceHandle := ""
+	if sourceVSC.Status != nil && sourceVSC.Status.SnapshotHandle != nil {
+		sourceHandle = *sourceVSC.Status.SnapshotHandle
+	}
+
+	if backupHandle == sourceHandle {
+		return nil
+	}
+
+	if !rebuild {
+		return withKind(ErrExposeConflict, errors.Errorf("existing backup VSC %s refers to snapshot handle %s, which differs from handle %s of the source snapshot",
+			backupVSCName, backupHandle, sourceHandle))
+	}
+
+	if !e.isCleanUpOwner(ownerObject, "VSC", func() (metav1.Object, error) { return backupVSC, nil }) {
+		return withKind(ErrExposeConflict, errors.Errorf("stale backup VSC %s belongs to another owner, delete it to retry", backupVSCName))
+	}
+
+	if !e.isCleanUpOwner(ownerObject, "VS", func() (metav1.Object, error) {
+		return e.csiSnapshotClient.VolumeSnapshots(namespace).Get(ctx, backupVSName, metav1.GetOptions{})
+	}) {
+		return withKind(ErrExposeConflict, errors.Errorf("stale backup VS %s/%s belongs to another owner, delete it to retry", namespace, backupVSName))
+	}
+
+	log.Warnf("Source snapshot has changed from handle %s to %s, rebuild backup VS and VSC", backupHandle, sourceHandle)
+
+	err = e.csiSnapshotClient.VolumeSnapshots(namespace).Delete(ctx, backupVSName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return withKind(ErrBackupSnapshotCreateFailed, errors.Wrapf(err, "error to delete stale backup VS %s", backupVSName))
+	}
+
+	if err := e.waitStaleBackupVSDeleted(ctx, namespace, backupVSName, timeout); err != nil {
+		return withKind(ErrBackupSnapshotCreateFailed, err)
+	}
+
+	if err := csi.EnsureDeleteVSC(ctx, e.csiSnapshotClient, backupVSCName, timeout); err != nil {
+		return withKind(ErrBackupSnapshotCreateFailed, errors.Wrapf(err, "error to delete stale backup VSC %s", backupVSCName))
+	}
+
+	return nil
+}
+
+// waitBackupVSBound waits the backup VS bound to the backup VSC, it fails immediately if the VS is bound to another VSC
+func (e *csiSnapshotExposer) waitBackupVSBound(ctx context.Context, backupVS *snapshotv1api.VolumeSnapshot, backupVSCName string, timeout time.Duration) error {
+	boundTo := ""
+	err := wait.PollUntilContextTimeout(ctx, backupVSBindPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		vs, err := e.csiSnapshotClient.VolumeSnapshots(backupVS.Namespace).Get(ctx, backupVS.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "error to get backup VS %s", backupVS.Name)
+		}
+
+		if vs.Status == nil || vs.Status.BoundVolumeSnapshotContentName == nil || *vs.Status.BoundVolumeSnapshotContentName == "" {
+			return false, nil
+		}
+
+		boundTo = *vs.Status.BoundVolumeSnapshotContentName
+		if boundTo != backupVSCName {
+			return false, errors.Errorf("backup VS %s is bound to VSC %s instead of the backup VSC %s", backupVS.Name, boundTo, backupVSCName)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return errors.Errorf("timeout to wait backup VS %s bound to VSC %s", backupVS.Name, backupVSCName)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// waitStaleBackupVSDeleted waits the existing backup VS which is being deleted to disappear.
+// If the existing VS is not being deleted, it fails immediately.
+func (e *csiSnapshotExposer) waitStaleBackupVSDeleted(ctx context.Context, namespace string, name string, timeout time.Duration) error {
+	err := wait.PollUntilContextTimeout(ctx, staleBackupVSPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		existing, err := e.csiSnapshotClient.VolumeSnapshots(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+
+			return false, errors.Wrapf(err, "error to get existing backup VS %s", name)
+		}
+
+		if existing.DeletionTimestamp == nil {
+			return false, errors.Errorf("backup VS %s already exists and is not being deleted", name)
+		}
+
+		return false, nil
+	})
+
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return errors.Errorf("timeout to wait stale backup VS %s deleted", name)
+		}
+
+		return err
+	}
+
+	return nil
 }
 
-func (e *csiSnapshotExposer) createBackupVSC(ctx context.Context, ownerObject corev1api.ObjectReference, snapshotVSC *snapshotv1api.VolumeSnapshotContent, vs *snapshotv1api.VolumeSnapshot) (*snapshotv1api.VolumeSnapshotContent, error) {
+// createBackupVSC creates the backup VSC from the snapshot handle and the source volume mode of the source VSC, or adopts the existing one of the same owner referring to the backup VS,
+// in which case true is returned
+func (e *csiSnapshotExposer) createBackupVSC(ctx context.Context, ownerObject corev1api.ObjectReference, snapshotVSC *snapshotv1api.VolumeSnapshotContent, vs *snapshotv1api.VolumeSnapshot, labels map[string]string, vsClass string,
+	deletionPolicy snapshotv1api.DeletionPolicy) (*snapshotv1api.VolumeSnapshotContent, bool, error) {
 	backupVSCName := ownerObject.Name
 
+	vsClassName := snapshotVSC.Spec.VolumeSnapshotClassName
+	if vsClass != "" {
+		vsClassName = &vsClass
+	}
+
 	vsc := &snapshotv1api.VolumeSnapshotContent{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        backupVSCName,
+			Labels:      labels,
 			Annotations: snapshotVSC.Annotations,
 		},
 		Spec: snapshotv1api.VolumeSnapshotContentSpec{
@@ -453,91 +3571,365 @@ func (e *csiSnapshotExposer) createBackupVSC(ctx context.Context, ownerObject co
 			Source: snapshotv1api.VolumeSnapshotContentSource{
 				SnapshotHandle: snapshotVSC.Status.SnapshotHandle,
 			},
-			DeletionPolicy:          snapshotv1api.VolumeSnapshotContentDelete,
+			DeletionPolicy:          deletionPolicy,
 			Driver:                  snapshotVSC.Spec.Driver,
-			VolumeSnapshotClassName: snapshotVSC.Spec.VolumeSnapshotClassName,
+			VolumeSnapshotClassName: vsClassName,
+			// some drivers require the mode of the source volume to reconstruct the snapshot from the handle
+			SourceVolumeMode: snapshotVSC.Spec.SourceVolumeMode,
+		},
+	}
+
+	created, err := e.csiSnapshotClient.VolumeSnapshotContents().Create(ctx, vsc, metav1.CreateOptions{})
+	if err == nil || !apierrors.IsAlreadyExists(err) {
+		return created, false, err
+	}
+
+	// the backup VSC may be created by a concurrent expose of the same owner, adopt it if it refers to the backup VS
+	existing, getErr := e.csiSnapshotClient.VolumeSnapshotContents().Get(ctx, vsc.Name, metav1.GetOptions{})
+	if getErr == nil && existing.DeletionTimestamp == nil && isExposeOwnedBy(existing, ownerObject) &&
+		existing.Spec.VolumeSnapshotRef.Name == vs.Name && existing.Spec.VolumeSnapshotRef.Namespace == vs.Namespace && existing.Spec.VolumeSnapshotRef.UID == vs.UID {
+		e.log.WithField("owner", ownerObject.Name).Infof("Adopt existing backup VSC %s", existing.Name)
+		return existing, true, nil
+	}
+
+	return nil, false, err
+}
+
+// createStaticBackupVSC creates the backup VSC pre-provisioned from the snapshot handle, which is bound by the backup VS created afterwards
+func (e *csiSnapshotExposer) createStaticBackupVSC(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string, snapshotHandle string, driver string, vsClass string,
+	labels map[string]string) (*snapshotv1api.VolumeSnapshotContent, error) {
+	backupVSName := ownerObject.Name
+	backupVSCName := ownerObject.Name
+
+	var vsClassName *string
+	if vsClass != "" {
+		vsClassName = &vsClass
+	}
+
+	vsc := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   backupVSCName,
+			Labels: labels,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			VolumeSnapshotRef: corev1api.ObjectReference{
+				Name:      backupVSName,
+				Namespace: namespace,
+			},
+			Source: snapshotv1api.VolumeSnapshotContentSource{
+				SnapshotHandle: &snapshotHandle,
+			},
+			// The snapshot is not owned by the expose, so keep it when the backup VS and VSC are deleted
+			DeletionPolicy:          snapshotv1api.VolumeSnapshotContentRetain,
+			Driver:                  driver,
+			VolumeSnapshotClassName: vsClassName,
 		},
 	}
 
 	return e.csiSnapshotClient.VolumeSnapshotContents().Create(ctx, vsc, metav1.CreateOptions{})
 }
 
-func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject corev1api.ObjectReference, backupVS, storageClass, accessMode string, resource resource.Quantity, readOnly bool) (*corev1api.PersistentVolumeClaim, error) {
+// getBackupPVCAccessMode returns the access mode of the backupPVC, which is ReadOnlyMany for the read-only backupPVC
+func getBackupPVCAccessMode(readOnly bool) corev1api.PersistentVolumeAccessMode {
+	if readOnly {
+		return corev1api.ReadOnlyMany
+	}
+
+	return corev1api.ReadWriteOnce
+}
+
+// createBackupPVC creates the backupPVC from the backup VS, if staticPV is specified, the backupPVC is pre-bound to it instead.
+// The selector only works with staticPV, since a PVC with a selector is not dynamically provisioned.
+// True is returned if the existing backupPVC of the same owner is adopted
+func (e *csiSnapshotExposer) createBackupPVC(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string, backupVS, storageClass, accessMode string, resource resource.Quantity, readOnly bool,
+	backoff wait.Backoff, staticPV string, volumeAttributesClass *string, selector *metav1.LabelSelector, annotations map[string]string) (*corev1api.PersistentVolumeClaim, bool, error) {
 	backupPVCName := ownerObject.Name
 
+	volumeMode, err := getVolumeModeByAccessMode(accessMode)
+	if err != nil {
+		return nil, false, err
+	}
+
+	pvcAccessMode := getBackupPVCAccessMode(readOnly)
+
+	var dataSource *corev1api.TypedLocalObjectReference
+	if staticPV == "" {
+		dataSource = &corev1api.TypedLocalObjectReference{
+			APIGroup: &snapshotv1api.SchemeGroupVersion.Group,
+			Kind:     "VolumeSnapshot",
+			Name:     backupVS,
+		}
+	}
+
+	pvcLabels := getCrossNamespaceOwnerLabels(ownerObject, namespace)
+	if duLabels := getDataUploadLabels(ownerObject); duLabels != nil {
+		pvcLabels = labels.Merge(pvcLabels, duLabels)
+	}
+
+	pvc := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       namespace,
+			Name:            backupPVCName,
+			Labels:          pvcLabels,
+			Annotations:     annotations,
+			OwnerReferences: getExposeOwnerReferences(ownerObject, namespace),
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			AccessModes: []corev1api.PersistentVolumeAccessMode{
+				pvcAccessMode,
+			},
+			StorageClassName: &storageClass,
+			VolumeMode:       &volumeMode,
+			VolumeName:       staticPV,
+			Selector:         selector,
+			DataSource:       dataSource,
+			DataSourceRef:    nil,
+
+			VolumeAttributesClassName: volumeAttributesClass,
+
+			Resources: corev1api.VolumeResourceRequirements{
+				Requests: corev1api.ResourceList{
+					corev1api.ResourceStorage: resource,
+				},
+			},
+		},
+	}
+
+	var created *corev1api.PersistentVolumeClaim
+	adopted := false
+	attempt := 0
+	err = wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		attempt++
+
+		var createErr error
+		created, createErr = e.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+		if createErr == nil {
+			return true, nil
+		}
+
+		// a previous attempt or a previous expose of the same owner may have created the backup pvc, adopt it
+		if apierrors.IsAlreadyExists(createErr) {
+			existing, getErr := e.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Get(ctx, pvc.Name, metav1.GetOptions{})
+			if getErr == nil && isExposeOwnedBy(existing, ownerObject) {
+				if attempt == 1 {
+					e.log.WithField("owner", ownerObject.Name).Infof("Adopt existing backup pvc %s/%s", existing.Namespace, existing.Name)
+				}
+
+				created = existing
+				adopted = true
+				return true, nil
+			}
+		}
+
+		if !isTransientAPIError(createErr) {
+			return false, createErr
+		}
+
+		e.log.WithField("owner", ownerObject.Name).WithError(createErr).Warnf("Transient error to create backup pvc at attempt %d", attempt)
+
+		if attempt >= backoff.Steps {
+			return false, createErr
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "error to create pvc")
+	}
+
+	return created, adopted, nil
+}
+
+// createStaticBackupPV creates the backup PV from the snapshot handle of the backup VSC, which is pre-bound to the backupPVC.
+// The reclaim policy is Retain, since the snapshot handle is not a volume owned by the backupPVC
+func (e *csiSnapshotExposer) createStaticBackupPV(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string, pvName string, backupVSC *snapshotv1api.VolumeSnapshotContent,
+	settings *backupPVCSettings, accessMode string, size resource.Quantity) (*corev1api.PersistentVolume, error) {
+	if backupVSC.Spec.Source.SnapshotHandle == nil || *backupVSC.Spec.Source.SnapshotHandle == "" {
+		return nil, errors.Errorf("backup VSC %s has no snapshot handle", backupVSC.Name)
+	}
+
 	volumeMode, err := getVolumeModeByAccessMode(accessMode)
 	if err != nil {
 		return nil, err
 	}
 
-	pvcAccessMode := corev1api.ReadWriteOnce
+	pvAccessMode := corev1api.ReadWriteOnce
+	if settings.readOnly {
+		pvAccessMode = corev1api.ReadOnlyMany
+	}
+
+	// label the static backup PV with the match labels of the selector mirrored onto the backupPVC, so that they bind
+	var pvLabels map[string]string
+	if settings.selector != nil && len(settings.selector.MatchLabels) > 0 {
+		pvLabels = maps.Clone(settings.selector.MatchLabels)
+	}
+
+	pv := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   pvName,
+			Labels: pvLabels,
+			Annotations: map[string]string{
+				staticBackupPVAnnotation: string(ownerObject.UID),
+			},
+		},
+		Spec: corev1api.PersistentVolumeSpec{
+			AccessModes: []corev1api.PersistentVolumeAccessMode{
+				pvAccessMode,
+			},
+			Capacity: corev1api.ResourceList{
+				corev1api.ResourceStorage: size,
+			},
+			ClaimRef: &corev1api.ObjectReference{
+				Kind:       "PersistentVolumeClaim",
+				APIVersion: "v1",
+				Namespace:  namespace,
+				Name:       ownerObject.Name,
+			},
+			PersistentVolumeReclaimPolicy: corev1api.PersistentVolumeReclaimRetain,
+			StorageClassName:              settings.storageClass,
+			VolumeMode:                    &volumeMode,
+			PersistentVolumeSource: corev1api.PersistentVolumeSource{
+				CSI: &corev1api.CSIPersistentVolumeSource{
+					Driver:       backupVSC.Spec.Driver,
+					VolumeHandle: *backupVSC.Spec.Source.SnapshotHandle,
+					ReadOnly:     settings.readOnly,
+				},
+			},
+		},
+	}
+
+	return e.kubeClient.CoreV1().PersistentVolumes().Create(ctx, pv, metav1.CreateOptions{})
+}
+
+// isStaticBackupPV returns whether the PV is a static backup PV created by the expose
+func isStaticBackupPV(pv *corev1api.PersistentVolume) bool {
+	_, found := pv.Annotations[staticBackupPVAnnotation]
+	return found
+}
+
+// isManagedBackupPV checks the backup PV could be deleted along with the backupPVC, i.e., its claim ref still points to the backupPVC
+// and its lifecycle is managed by the expose: it is the static backup PV, it is dynamically provisioned, or its reclaim policy is Delete.
+// Otherwise, it returns the reason, e.g., a statically provisioned PV reused by a user whose name collides with the backupPVC's volume
+func isManagedBackupPV(pv *corev1api.PersistentVolume, pvc *corev1api.PersistentVolumeClaim) (bool, string) {
+	claimRef := pv.Spec.ClaimRef
+	if claimRef == nil || claimRef.Namespace != pvc.Namespace || claimRef.Name != pvc.Name || (claimRef.UID != "" && claimRef.UID != pvc.UID) {
+		return false, "its claim ref doesn't point to the backup PVC"
+	}
+
+	if isStaticBackupPV(pv) || pv.Annotations[kube.KubeAnnDynamicallyProvisioned] != "" {
+		return true, ""
+	}
+
+	if pv.Spec.PersistentVolumeReclaimPolicy == corev1api.PersistentVolumeReclaimDelete {
+		return true, ""
+	}
+
+	return false, fmt.Sprintf("it is neither provisioned for the backup PVC nor with the reclaim policy Delete, but %s", pv.Spec.PersistentVolumeReclaimPolicy)
+}
+
+// deleteBackupPVAndPVC deletes the backupPVC and the backup PV bound to it, it continues on failures and returns the aggregated errors.
+// A static backup PV is deleted directly without changing its reclaim policy, so that the snapshot handle it refers to is kept.
+// The backup PV not managed by the expose is kept, only the backupPVC is deleted
+func (e *csiSnapshotExposer) deleteBackupPVAndPVC(ctx context.Context, namespace string, pvcName string, timeout time.Duration, log logrus.FieldLogger) error {
+	pvc, err := e.kubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, pvcName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, "error to get backup pvc %s/%s", namespace, pvcName)
+	}
+
+	ensureDeletePVC := func() error {
+		if err := kube.EnsureDeletePVC(ctx, e.kubeClient.CoreV1(), pvcName, namespace, timeout); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error to delete backup pvc %s/%s", namespace, pvcName)
+		}
+
+		return nil
+	}
+
+	if pvc.Spec.VolumeName == "" {
+		log.Warnf("Backup pvc %s/%s has no bound volume, only delete the pvc", namespace, pvcName)
+		return ensureDeletePVC()
+	}
+
+	errs := []error{}
+	pv, err := e.kubeClient.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err == nil {
+		if managed, reason := isManagedBackupPV(pv, pvc); !managed {
+			log.Warnf("Skip deleting backup PV %s bound to backup pvc %s/%s, %s", pv.Name, namespace, pvcName, reason)
+			return ensureDeletePVC()
+		}
+
+		if isStaticBackupPV(pv) {
+			errs = append(errs, ensureDeletePVC())
+
+			if err := e.kubeClient.CoreV1().PersistentVolumes().Delete(ctx, pv.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, errors.Wrapf(err, "error to delete backup PV %s", pv.Name))
+			}
+
+			return kerrors.NewAggregate(errs)
+		}
+
+		if _, err := kube.SetPVReclaimPolicy(ctx, e.kubeClient.CoreV1(), pv, corev1api.PersistentVolumeReclaimDelete); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error to set reclaim policy of backup PV %s to delete", pv.Name))
+		}
+	} else if !apierrors.IsNotFound(err) {
+		errs = append(errs, errors.Wrapf(err, "error to get backup PV %s", pvc.Spec.VolumeName))
+	}
+
+	errs = append(errs, ensureDeletePVC())
 
-	if readOnly {
-		pvcAccessMode = corev1api.ReadOnlyMany
+	if err := kube.EnsurePVDeleted(ctx, e.kubeClient.CoreV1(), pvc.Spec.VolumeName, timeout); err != nil {
+		errs = append(errs, errors.Wrapf(err, "error to wait backup PV %s deleted", pvc.Spec.VolumeName))
 	}
 
-	dataSource := &corev1api.TypedLocalObjectReference{
-		APIGroup: &snapshotv1api.SchemeGroupVersion.Group,
-		Kind:     "VolumeSnapshot",
-		Name:     backupVS,
-	}
+	return kerrors.NewAggregate(errs)
+}
 
-	pvc := &corev1api.PersistentVolumeClaim{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: ownerObject.Namespace,
-			Name:      backupPVCName,
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: ownerObject.APIVersion,
-					Kind:       ownerObject.Kind,
-					Name:       ownerObject.Name,
-					UID:        ownerObject.UID,
-					Controller: boolptr.True(),
-				},
-			},
-		},
-		Spec: corev1api.PersistentVolumeClaimSpec{
-			AccessModes: []corev1api.PersistentVolumeAccessMode{
-				pvcAccessMode,
-			},
-			StorageClassName: &storageClass,
-			VolumeMode:       &volumeMode,
-			DataSource:       dataSource,
-			DataSourceRef:    nil,
+const defaultPVCCreateRetryBaseDelay = time.Second
 
-			Resources: corev1api.VolumeResourceRequirements{
-				Requests: corev1api.ResourceList{
-					corev1api.ResourceStorage: resource,
-				},
-			},
-		},
+// getPVCCreateBackoff returns the backoff to create the backupPVC, by default, it makes a single attempt
+func getPVCCreateBackoff(param *CSISnapshotExposeParam) wait.Backoff {
+	steps := param.PVCCreateMaxAttempts
+	if steps < 1 {
+		steps = 1
 	}
 
-	created, err := e.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
-	if err != nil {
-		return nil, errors.Wrap(err, "error to create pvc")
+	delay := param.PVCCreateRetryBaseDelay
+	if delay <= 0 {
+		delay = defaultPVCCreateRetryBaseDelay
 	}
 
-	return created, err
+	return wait.Backoff{
+		Duration: delay,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    steps,
+	}
+}
+
+// isTransientAPIError checks if the error returned by the API server is transient, so the request could be retried
+func isTransientAPIError(err error) bool {
+	return apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err) || apierrors.IsConflict(err)
 }
 
 func (e *csiSnapshotExposer) createBackupPod(
 	ctx context.Context,
 	ownerObject corev1api.ObjectReference,
 	backupPVC *corev1api.PersistentVolumeClaim,
-	operationTimeout time.Duration,
-	label map[string]string,
-	annotation map[string]string,
-	affinity *kube.LoadAffinity,
-	resources corev1api.ResourceRequirements,
+	param *CSISnapshotExposeParam,
 	backupPVCReadOnly bool,
 	spcNoRelabeling bool,
 	nodeOS string,
+	csiDriver string,
+	extraEnv []corev1api.EnvVar,
 ) (*corev1api.Pod, error) {
 	podName := ownerObject.Name
+	podNamespace := getExposeNamespace(ownerObject, param.TargetNamespace)
 
-	containerName := string(ownerObject.UID)
-	volumeName := string(ownerObject.UID)
+	// the backup container and the backup volume are both named by the expose name
+	exposeName := getExposeName(ownerObject, param.NameSource)
 
 	podInfo, err := getInheritedPodInfo(ctx, e.kubeClient, ownerObject.Namespace, nodeOS)
 	if err != nil {
@@ -545,45 +3937,100 @@ func (e *csiSnapshotExposer) createBackupPod(
 	}
 
 	var gracePeriod int64
-	volumeMounts, volumeDevices, volumePath := kube.MakePodPVCAttachment(volumeName, backupPVC.Spec.VolumeMode, backupPVCReadOnly)
+	if param.PodTerminationGracePeriod != nil {
+		gracePeriod = *param.PodTerminationGracePeriod
+	}
+	volumeMounts, volumeDevices, volumePath := kube.MakePodPVCAttachment(exposeName, backupPVC.Spec.VolumeMode, backupPVCReadOnly)
 	volumeMounts = append(volumeMounts, podInfo.volumeMounts...)
+	volumeMounts = append(volumeMounts, param.ExtraVolumeMounts...)
 
+	// VolumeDevice has no read-only flag, so for block mode, the read-only intent is only propagated by
+	// the PVC volume source, with which kubelet maps the block device as read-only
 	volumes := []corev1api.Volume{{
-		Name: volumeName,
+		Name: exposeName,
 		VolumeSource: corev1api.VolumeSource{
 			PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
 				ClaimName: backupPVC.Name,
+				ReadOnly:  backupPVCReadOnly,
 			},
 		},
 	}}
 
-	if backupPVCReadOnly {
-		volumes[0].VolumeSource.PersistentVolumeClaim.ReadOnly = true
-	}
-
 	volumes = append(volumes, podInfo.volumes...)
+	volumes = append(volumes, param.ExtraVolumes...)
+
+	if param.MountWorkDir {
+		workDir, workDirMount := workDirVolume(e.workDirBasePath, ownerObject)
+		volumes = append(volumes, workDir)
+		volumeMounts = append(volumeMounts, workDirMount)
+	}
 
+	label := param.HostingPodLabels
 	if label == nil {
 		label = make(map[string]string)
 	}
 	label[podGroupLabel] = podGroupSnapshot
+	maps.Copy(label, getDataUploadLabels(ownerObject))
+	if e.podDisruptionBudget {
+		label[podDisruptionBudgetLabel] = exposeName
+	}
+
+	annotation := make(map[string]string)
+	if !param.AllowSidecarInjection {
+		for k, v := range sidecarExclusionAnnotations {
+			annotation[k] = v
+		}
+	}
+
+	for k, v := range param.HostingPodAnnotations {
+		annotation[k] = v
+	}
+
+	restartPolicy := corev1api.RestartPolicyNever
+	if param.PodRestartPolicy == corev1api.RestartPolicyOnFailure {
+		restartPolicy = corev1api.RestartPolicyOnFailure
+
+		if param.PodRestartLimit > 0 {
+			annotation[podRestartLimitAnnotation] = strconv.Itoa(int(param.PodRestartLimit))
+		}
+	}
 
 	volumeMode := corev1api.PersistentVolumeFilesystem
 	if backupPVC.Spec.VolumeMode != nil {
 		volumeMode = *backupPVC.Spec.VolumeMode
 	}
 
-	args := []string{
-		fmt.Sprintf("--volume-path=%s", volumePath),
-		fmt.Sprintf("--volume-mode=%s", volumeMode),
-		fmt.Sprintf("--data-upload=%s", ownerObject.Name),
-		fmt.Sprintf("--resource-timeout=%s", operationTimeout.String()),
+	var commandBuilder BackupPodCommandBuilder = DefaultBackupPodCommandBuilder{Direction: param.Direction}
+	if e.backupPodCommandBuilder != nil {
+		commandBuilder = e.backupPodCommandBuilder
 	}
 
+	command, args := commandBuilder.Build(volumePath, volumeMode, ownerObject.Name, param.OperationTimeout)
 	args = append(args, podInfo.logFormatArgs...)
 	args = append(args, podInfo.logLevelArgs...)
 
+	resources := param.Resources
+	if isResourceRequirementsEmpty(resources) {
+		var recommender BackupPodResourceRecommender = DefaultBackupPodResourceRecommender{}
+		if e.backupPodResourceRecommender != nil {
+			recommender = e.backupPodResourceRecommender
+		}
+
+		resources = recommender.RecommendResources(backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage], volumeMode)
+	}
+
+	nodeOSLabelKeys := param.NodeOSLabelKeys
+	if len(nodeOSLabelKeys) == 0 {
+		nodeOSLabelKeys = []string{kube.NodeOSLabel}
+	}
+
+	imagePullPolicy := corev1api.PullNever
+	if param.ImagePullPolicy != "" {
+		imagePullPolicy = param.ImagePullPolicy
+	}
+
 	var securityCtx *corev1api.PodSecurityContext
+	var containerSecurityCtx *corev1api.SecurityContext
 	nodeSelector := map[string]string{}
 	podOS := corev1api.PodOS{}
 	toleration := []corev1api.Toleration{}
@@ -595,54 +4042,88 @@ func (e *csiSnapshotExposer) createBackupPod(
 			},
 		}
 
-		nodeSelector[kube.NodeOSLabel] = kube.NodeOSWindows
+		for _, key := range nodeOSLabelKeys {
+			nodeSelector[key] = kube.NodeOSWindows
+		}
 		podOS.Name = kube.NodeOSWindows
 
-		toleration = append(toleration, corev1api.Toleration{
-			Key:      "os",
-			Operator: "Equal",
-			Effect:   "NoSchedule",
-			Value:    "windows",
-		})
+		if !param.SkipDefaultWindowsToleration {
+			toleration = append(toleration, corev1api.Toleration{
+				Key:      "os",
+				Operator: "Equal",
+				Effect:   "NoSchedule",
+				Value:    "windows",
+			})
+		}
+
+		toleration = append(toleration, param.WindowsTolerations...)
+
+		if param.SeccompProfile != nil {
+			e.log.WithField("owner", ownerObject.Name).Info("Seccomp profile is ignored for the Windows backup pod")
+		}
+
+		if param.SELinuxOptions != nil {
+			e.log.WithField("owner", ownerObject.Name).Info("SELinux options are ignored for the Windows backup pod")
+		}
+
+		if param.FSGroup != nil {
+			e.log.WithField("owner", ownerObject.Name).Info("FSGroup is ignored for the Windows backup pod")
+		}
 	} else {
-		userID := int64(0)
-		securityCtx = &corev1api.PodSecurityContext{
-			RunAsUser: &userID,
+		if param.RunAsNonRoot {
+			securityCtx = &corev1api.PodSecurityContext{
+				RunAsNonRoot: boolptr.True(),
+				RunAsUser:    param.RunAsUserID,
+			}
+		} else {
+			userID := int64(0)
+			securityCtx = &corev1api.PodSecurityContext{
+				RunAsUser: &userID,
+			}
 		}
 
-		if spcNoRelabeling {
-			securityCtx.SELinuxOptions = &corev1api.SELinuxOptions{
-				Type: "spc_t",
+		if param.SELinuxOptions != nil {
+			securityCtx.SELinuxOptions = param.SELinuxOptions.DeepCopy()
+		} else if spcNoRelabeling {
+			securityCtx.SELinuxOptions = &corev1api.SELinuxOptions{}
+		}
+
+		if spcNoRelabeling && securityCtx.SELinuxOptions.Type == "" {
+			securityCtx.SELinuxOptions.Type = "spc_t"
+		}
+
+		if param.FSGroup != nil {
+			fsGroup := *param.FSGroup
+			securityCtx.FSGroup = &fsGroup
+		}
+
+		if param.FSGroupChangePolicy != nil {
+			fsGroupChangePolicy := *param.FSGroupChangePolicy
+			securityCtx.FSGroupChangePolicy = &fsGroupChangePolicy
+		}
+
+		if param.SeccompProfile != nil {
+			securityCtx.SeccompProfile = param.SeccompProfile.DeepCopy()
+			containerSecurityCtx = &corev1api.SecurityContext{
+				SeccompProfile: param.SeccompProfile.DeepCopy(),
 			}
 		}
 
-		nodeSelector[kube.NodeOSLabel] = kube.NodeOSLinux
+		for _, key := range nodeOSLabelKeys {
+			nodeSelector[key] = kube.NodeOSLinux
+		}
 		podOS.Name = kube.NodeOSLinux
 	}
 
+	var topologySpread []corev1api.TopologySpreadConstraint
 	var podAffinity *corev1api.Affinity
-	if affinity != nil {
-		podAffinity = kube.ToSystemAffinity([]*kube.LoadAffinity{affinity})
-	}
-
-	pod := &corev1api.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
-			Namespace: ownerObject.Namespace,
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: ownerObject.APIVersion,
-					Kind:       ownerObject.Kind,
-					Name:       ownerObject.Name,
-					UID:        ownerObject.UID,
-					Controller: boolptr.True(),
-				},
-			},
-			Labels:      label,
-			Annotations: annotation,
-		},
-		Spec: corev1api.PodSpec{
-			TopologySpreadConstraints: []corev1api.TopologySpreadConstraint{
+	if param.NodeName != "" {
+		// the pod is bound to the node directly, so the scheduling constraints don't apply
+		e.log.WithField("owner", ownerObject.Name).Infof("Pin backup pod to node %s, skip the affinity and topology spread", param.NodeName)
+	} else {
+		topologySpread = param.TopologySpread
+		if topologySpread == nil {
+			topologySpread = []corev1api.TopologySpreadConstraint{
 				{
 					MaxSkew:           1,
 					TopologyKey:       "kubernetes.io/hostname",
@@ -653,32 +4134,63 @@ func (e *csiSnapshotExposer) createBackupPod(
 						},
 					},
 				},
-			},
-			NodeSelector: nodeSelector,
-			OS:           &podOS,
-			Affinity:     podAffinity,
+			}
+		}
+
+		podAffinity = addNodeAgentAffinity(kube.ToSystemAffinity(param.Affinities), param.NodeAgentAffinity, ownerObject.Namespace)
+
+		if param.MaxExposePodsPerNode > 0 {
+			if nodes, err := e.getFullyLoadedNodes(ctx, podNamespace, param.MaxExposePodsPerNode); err != nil {
+				e.log.WithError(err).Warn("Failed to get the nodes fully loaded with expose pods, skip the exclusion")
+			} else if len(nodes) > 0 {
+				e.log.WithField("owner", ownerObject.Name).Infof("Exclude nodes %v which host %d or more expose pods", nodes, param.MaxExposePodsPerNode)
+				podAffinity = excludeNodesFromAffinity(podAffinity, nodes)
+			}
+		}
+
+		if param.AvoidNodesAtAttachLimit && csiDriver != "" {
+			if nodes, err := e.getNodesAtAttachLimit(ctx, csiDriver); err != nil {
+				e.log.WithError(err).Warn("Failed to get the nodes at attach limit, skip the exclusion")
+			} else if len(nodes) > 0 {
+				e.log.WithField("owner", ownerObject.Name).Infof("Exclude nodes %v which are at the attach limit of driver %s", nodes, csiDriver)
+				podAffinity = excludeNodesFromAffinity(podAffinity, nodes)
+			}
+		}
+	}
+
+	pod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            podName,
+			Namespace:       podNamespace,
+			OwnerReferences: getExposeOwnerReferences(ownerObject, podNamespace),
+			Labels:          labels.Merge(label, getCrossNamespaceOwnerLabels(ownerObject, podNamespace)),
+			Annotations:     annotation,
+		},
+		Spec: corev1api.PodSpec{
+			TopologySpreadConstraints: topologySpread,
+			NodeSelector:              nodeSelector,
+			OS:                        &podOS,
+			Affinity:                  podAffinity,
 			Containers: []corev1api.Container{
 				{
-					Name:            containerName,
+					Name:            exposeName,
 					Image:           podInfo.image,
-					ImagePullPolicy: corev1api.PullNever,
-					Command: []string{
-						"/velero",
-						"data-mover",
-						"backup",
-					},
-					Args:          args,
-					VolumeMounts:  volumeMounts,
-					VolumeDevices: volumeDevices,
-					Env:           podInfo.env,
-					EnvFrom:       podInfo.envFrom,
-					Resources:     resources,
+					ImagePullPolicy: imagePullPolicy,
+					Command:         command,
+					Args:            args,
+					VolumeMounts:    volumeMounts,
+					VolumeDevices:   volumeDevices,
+					Env:             append(podInfo.env, extraEnv...),
+					EnvFrom:         podInfo.envFrom,
+					Resources:       resources,
+					ReadinessProbe:  param.ReadinessProbe,
+					SecurityContext: containerSecurityCtx,
 				},
 			},
 			ServiceAccountName:            podInfo.serviceAccount,
 			TerminationGracePeriodSeconds: &gracePeriod,
 			Volumes:                       volumes,
-			RestartPolicy:                 corev1api.RestartPolicyNever,
+			RestartPolicy:                 restartPolicy,
 			SecurityContext:               securityCtx,
 			Tolerations:                   toleration,
 			DNSPolicy:                     podInfo.dnsPolicy,
@@ -686,5 +4198,335 @@ func (e *csiSnapshotExposer) createBackupPod(
 		},
 	}
 
-	return e.kubeClient.CoreV1().Pods(ownerObject.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if param.PodActiveDeadline > 0 {
+		// round up, so that a sub-second deadline is not turned into zero
+		activeDeadlineSeconds := int64(math.Ceil(param.PodActiveDeadline.Seconds()))
+		pod.Spec.ActiveDeadlineSeconds = &activeDeadlineSeconds
+	}
+
+	if param.DNSPolicy != nil {
+		pod.Spec.DNSPolicy = *param.DNSPolicy
+	}
+
+	if param.DNSConfig != nil {
+		pod.Spec.DNSConfig = param.DNSConfig
+	}
+
+	if len(param.HostAliases) > 0 {
+		pod.Spec.HostAliases = param.HostAliases
+	}
+
+	if param.NodeName != "" {
+		pod.Spec.NodeName = param.NodeName
+	}
+
+	if param.SchedulerName != "" {
+		pod.Spec.SchedulerName = param.SchedulerName
+	}
+
+	if param.AutomountServiceAccountToken != nil {
+		pod.Spec.AutomountServiceAccountToken = param.AutomountServiceAccountToken
+	}
+
+	if param.RuntimeClassName != nil {
+		pod.Spec.RuntimeClassName = param.RuntimeClassName
+	}
+
+	created, err := e.kubeClient.CoreV1().Pods(podNamespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil && apierrors.IsAlreadyExists(err) {
+		if param.AdoptExistingBackupPod || e.isBackupPodOwnedBy(ctx, podNamespace, pod.Name, ownerObject) {
+			return e.adoptOrRecreateBackupPod(ctx, ownerObject, pod, backupPVC.Name, exposeName, param.OperationTimeout)
+		}
+	}
+
+	return created, err
+}
+
+// validateBackupNode checks the node pinned by NodeName exists and is labeled with the OS of the backup pod for each of the node OS label keys,
+// otherwise, the backup pod bound to the node is never started
+func (e *csiSnapshotExposer) validateBackupNode(ctx context.Context, nodeName string, nodeOS string, nodeOSLabelKeys []string) error {
+	node, err := e.kubeClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return withKind(ErrInvalidExposeParam, errors.Errorf("node %s to run the backup pod doesn't exist", nodeName))
+		}
+
+		return errors.Wrapf(err, "error to get node %s", nodeName)
+	}
+
+	if len(nodeOSLabelKeys) == 0 {
+		nodeOSLabelKeys = []string{kube.NodeOSLabel}
+	}
+
+	for _, key := range nodeOSLabelKeys {
+		if os := node.Labels[key]; os != nodeOS {
+			return withKind(ErrInvalidExposeParam, errors.Errorf("node %s is labeled with %s=%s, which doesn't match the OS %s of the backup pod", nodeName, key, os, nodeOS))
+		}
+	}
+
+	return nil
+}
+
+// getFullyLoadedNodes returns the nodes hosting maxPods or more active expose pods
+func (e *csiSnapshotExposer) getFullyLoadedNodes(ctx context.Context, namespace string, maxPods int) ([]string, error) {
+	pods, err := e.kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: podGroupLabel})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list expose pods")
+	}
+
+	podsPerNode := map[string]int{}
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1api.PodSucceeded || pod.Status.Phase == corev1api.PodFailed {
+			continue
+		}
+
+		podsPerNode[pod.Spec.NodeName]++
+	}
+
+	nodes := []string{}
+	for node, count := range podsPerNode {
+		if count >= maxPods {
+			nodes = append(nodes, node)
+		}
+	}
+
+	sort.Strings(nodes)
+
+	return nodes, nil
+}
+
+// getNodesAtAttachLimit returns the nodes on which the volumes attached by the CSI driver have reached the allocatable count reported by CSINode
+func (e *csiSnapshotExposer) getNodesAtAttachLimit(ctx context.Context, driver string) ([]string, error) {
+	csiNodes, err := e.kubeClient.StorageV1().CSINodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list CSI nodes")
+	}
+
+	attachments, err := e.kubeClient.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list volume attachments")
+	}
+
+	attachedPerNode := map[string]int32{}
+	for _, attachment := range attachments.Items {
+		if attachment.Spec.Attacher == driver {
+			attachedPerNode[attachment.Spec.NodeName]++
+		}
+	}
+
+	nodes := []string{}
+	for _, csiNode := range csiNodes.Items {
+		for _, nodeDriver := range csiNode.Spec.Drivers {
+			if nodeDriver.Name != driver || nodeDriver.Allocatable == nil || nodeDriver.Allocatable.Count == nil {
+				continue
+			}
+
+			// CSINode has the same name as the node
+			if attachedPerNode[csiNode.Name] >= *nodeDriver.Allocatable.Count {
+				nodes = append(nodes, csiNode.Name)
+			}
+		}
+	}
+
+	sort.Strings(nodes)
+
+	return nodes, nil
+}
+
+// nodeAgentRoleLabel and nodeAgentRole are the label key and value of the node-agent pods
+const (
+	nodeAgentRoleLabel = "role"
+	nodeAgentRole      = "node-agent"
+)
+
+// addNodeAgentAffinity adds the pod affinity to the node-agent pods in the namespace by the mode, either as a required or a preferred term
+func addNodeAgentAffinity(affinity *corev1api.Affinity, mode NodeAgentAffinityMode, namespace string) *corev1api.Affinity {
+	if mode != NodeAgentAffinityRequired && mode != NodeAgentAffinityPreferred {
+		return affinity
+	}
+
+	term := corev1api.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{nodeAgentRoleLabel: nodeAgentRole},
+		},
+		Namespaces:  []string{namespace},
+		TopologyKey: corev1api.LabelHostname,
+	}
+
+	if affinity == nil {
+		affinity = &corev1api.Affinity{}
+	}
+
+	if affinity.PodAffinity == nil {
+		affinity.PodAffinity = &corev1api.PodAffinity{}
+	}
+
+	if mode == NodeAgentAffinityRequired {
+		affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+	} else {
+		affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			corev1api.WeightedPodAffinityTerm{Weight: 100, PodAffinityTerm: term})
+	}
+
+	return affinity
+}
+
+// excludeNodesFromAffinity adds the requirement excluding the nodes to each node selector term of the affinity,
+// since the terms are ORed
+func excludeNodesFromAffinity(affinity *corev1api.Affinity, nodes []string) *corev1api.Affinity {
+	exclusion := corev1api.NodeSelectorRequirement{
+		Key:      metav1.ObjectNameField,
+		Operator: corev1api.NodeSelectorOpNotIn,
+		Values:   nodes,
+	}
+
+	if affinity == nil {
+		affinity = &corev1api.Affinity{}
+	}
+
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1api.NodeAffinity{}
+	}
+
+	if affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1api.NodeSelector{}
+	}
+
+	selector := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(selector.NodeSelectorTerms) == 0 {
+		selector.NodeSelectorTerms = []corev1api.NodeSelectorTerm{{}}
+	}
+
+	for i := range selector.NodeSelectorTerms {
+		selector.NodeSelectorTerms[i].MatchFields = append(selector.NodeSelectorTerms[i].MatchFields, exclusion)
+	}
+
+	return affinity
+}
+
+// isBackupPodOwnedBy checks whether the existing backup pod is controlled by the owner, so that it is adopted or recreated by default
+func (e *csiSnapshotExposer) isBackupPodOwnedBy(ctx context.Context, namespace string, name string, ownerObject corev1api.ObjectReference) bool {
+	existing, err := e.kubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+
+	return isExposeOwnedBy(existing, ownerObject)
+}
+
+func (e *csiSnapshotExposer) adoptOrRecreateBackupPod(ctx context.Context, ownerObject corev1api.ObjectReference, pod *corev1api.Pod,
+	backupPVCName string, volumeName string, operationTimeout time.Duration) (*corev1api.Pod, error) {
+	existing, err := e.kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to get existing backup pod %s", pod.Name)
+	}
+
+	if isBackupPodCompatible(existing, ownerObject, backupPVCName, volumeName) {
+		e.log.WithField("owner", ownerObject.Name).WithField("pod name", existing.Name).Info("Adopt existing backup pod")
+		return existing, nil
+	}
+
+	e.log.WithField("owner", ownerObject.Name).WithField("pod name", existing.Name).Warn("Existing backup pod is incompatible, recreate it")
+
+	if err := kube.EnsureDeletePod(ctx, e.kubeClient.CoreV1(), existing.Name, existing.Namespace, operationTimeout); err != nil {
+		return nil, errors.Wrapf(err, "error to delete incompatible backup pod %s", existing.Name)
+	}
+
+	return e.kubeClient.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+}
+
+// AdoptOrphanedBackupPods finds the backup pods running in the node whose owners are still live, e.g., the backup pods
+// left by a restarted node-agent, and returns the references of their owners, with which GetExposed and CleanUp work again for the pods.
+// The owners being deleted are also returned, so that their objects could be cleaned up. The backup pods of the gone owners are left
+// to the garbage collector. It requires the owner client set by WithOwnerFinalizer or WithOwnerConditions to check the owners
+func (e *csiSnapshotExposer) AdoptOrphanedBackupPods(ctx context.Context, namespace string, nodeName string) ([]corev1api.ObjectReference, error) {
+	ownerClient := e.ownerClient
+	if ownerClient == nil {
+		ownerClient = e.conditionClient
+	}
+
+	if ownerClient == nil {
+		return nil, errors.New("owner client is not set")
+	}
+
+	pods, err := e.kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", podGroupLabel, podGroupSnapshot),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list backup pods")
+	}
+
+	owners := []corev1api.ObjectReference{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName != nodeName || pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if pod.Status.Phase == corev1api.PodSucceeded || pod.Status.Phase == corev1api.PodFailed {
+			continue
+		}
+
+		ownerObject, found := getExposeOwnerOf(pod)
+		if !found {
+			e.log.Warnf("Backup pod %s/%s has no owner, skip adopting it", pod.Namespace, pod.Name)
+			continue
+		}
+
+		live, err := isOwnerLive(ctx, ownerClient, ownerObject)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error to check owner of backup pod %s/%s", pod.Namespace, pod.Name)
+		}
+
+		if !live {
+			e.log.Infof("Owner %s of backup pod %s/%s is gone, skip adopting it", ownerObject.Name, pod.Namespace, pod.Name)
+			continue
+		}
+
+		e.log.WithField("owner", ownerObject.Name).Infof("Adopted orphaned backup pod %s/%s in node %s", pod.Namespace, pod.Name, nodeName)
+		e.storeExposeNamespace(ownerObject, pod.Namespace)
+		owners = append(owners, ownerObject)
+	}
+
+	return owners, nil
+}
+
+// isOwnerLive checks if the owner exists with the same UID
+func isOwnerLive(ctx context.Context, ownerClient client.Client, ownerObject corev1api.ObjectReference) (bool, error) {
+	gv, err := schema.ParseGroupVersion(ownerObject.APIVersion)
+	if err != nil {
+		return false, errors.Wrapf(err, "error to parse API version of owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+	}
+
+	owner := &unstructured.Unstructured{}
+	owner.SetGroupVersionKind(gv.WithKind(ownerObject.Kind))
+
+	if err := ownerClient.Get(ctx, client.ObjectKey{Namespace: ownerObject.Namespace, Name: ownerObject.Name}, owner); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, errors.Wrapf(err, "error to get owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+	}
+
+	return owner.GetUID() == ownerObject.UID, nil
+}
+
+// isBackupPodCompatible checks if the existing backup pod is owned by the owner and mounts the backup PVC with the expected volume name
+func isBackupPodCompatible(pod *corev1api.Pod, ownerObject corev1api.ObjectReference, backupPVCName string, volumeName string) bool {
+	if pod.DeletionTimestamp != nil || isPodTerminated(pod) {
+		return false
+	}
+
+	if !isExposeOwnedBy(pod, ownerObject) {
+		return false
+	}
+
+	for _, vol := range pod.Spec.Volumes {
+		if vol.Name == volumeName {
+			return vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.ClaimName == backupPVCName
+		}
+	}
+
+	return false
 }
@@ -19,28 +19,43 @@ package exposer
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
 	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	snapshotter "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/typed/volumesnapshot/v1"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1api "k8s.io/api/apps/v1"
 	corev1api "k8s.io/api/core/v1"
+	nodev1api "k8s.io/api/node/v1"
+	storagev1api "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	clientTesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -64,6 +79,25 @@ func TestExpose(t *testing.T) {
 		},
 	}
 
+	backupWithoutUID := backup.DeepCopy()
+	backupWithoutUID.UID = ""
+
+	leftoverObjectMeta := func(ownerUID types.UID) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1.SchemeGroupVersion.String(),
+					Kind:       "Backup",
+					Name:       "fake-backup",
+					UID:        ownerUID,
+					Controller: boolptr.True(),
+				},
+			},
+		}
+	}
+
 	var restoreSize int64 = 123456
 
 	snapshotClass := "fake-snapshot-class"
@@ -89,6 +123,30 @@ func TestExpose(t *testing.T) {
 		},
 	}
 
+	sourcePVCName := "fake-source-pvc"
+	vsObjectWithSourcePVC := vsObject.DeepCopy()
+	vsObjectWithSourcePVC.Spec.Source.PersistentVolumeClaimName = &sourcePVCName
+
+	sourcePVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-ns",
+			Name:      sourcePVCName,
+			Annotations: map[string]string{
+				"fake-driver.io/encryption-key":   "fake-key-ref",
+				"fake-driver.io/other":            "fake-other",
+				"velero.io/fake-managed":          "fake-value",
+				kube.KubeAnnSelectedNode:          "fake-node",
+				"pv.kubernetes.io/bind-completed": "yes",
+			},
+		},
+	}
+
+	vsObjectWithLabels := vsObject.DeepCopy()
+	vsObjectWithLabels.Labels = map[string]string{
+		"cost-center":           "fake-cost-center",
+		"velero.io/backup-name": "fake-backup",
+	}
+
 	vsObjectWithoutRestoreSize := &snapshotv1api.VolumeSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "fake-vs",
@@ -152,6 +210,29 @@ func TestExpose(t *testing.T) {
 		},
 	}
 
+	dnsDaemonSet := daemonSet.DeepCopy()
+	dnsDaemonSet.Spec.Template.Spec.DNSPolicy = corev1api.DNSClusterFirstWithHostNet
+	dnsDaemonSet.Spec.Template.Spec.DNSConfig = &corev1api.PodDNSConfig{
+		Nameservers: []string{"10.0.0.10"},
+	}
+
+	dnsPolicyNone := corev1api.DNSNone
+
+	staleHandle := "fake-stale-handle"
+	staleBackupVSC := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: backup.Name,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			Source: snapshotv1api.VolumeSnapshotContentSource{
+				SnapshotHandle: &staleHandle,
+			},
+		},
+	}
+
+	immediateBindingMode := storagev1api.VolumeBindingImmediate
+	waitForFirstConsumerBindingMode := storagev1api.VolumeBindingWaitForFirstConsumer
+
 	tests := []struct {
 		name                          string
 		snapshotClientObj             []runtime.Object
@@ -160,11 +241,38 @@ func TestExpose(t *testing.T) {
 		exposeParam                   CSISnapshotExposeParam
 		snapReactors                  []reactor
 		kubeReactors                  []reactor
+		sourceVSClassDeleted          bool
+		kubeAPIResources              []*metav1.APIResourceList
 		err                           string
 		expectedVolumeSize            *resource.Quantity
 		expectedReadOnlyPVC           bool
+		expectedVolumeDevices         []corev1api.VolumeDevice
 		expectedBackupPVCStorageClass string
 		expectedAffinity              *corev1api.Affinity
+		expectedPodAnnotations        map[string]string
+		expectedSecurityContext       *corev1api.PodSecurityContext
+		expectedNodeSelector          map[string]string
+		expectedActiveDeadlineSeconds *int64
+		expectedBackupVSClass         string
+		expectedBackupSnapshotLabels  map[string]string
+		expectedEnv                   []corev1api.EnvVar
+		expectedResources             *corev1api.ResourceRequirements
+		expectedTopologySpread        []corev1api.TopologySpreadConstraint
+		expectedStaticBackupPV        string
+		expectedBackupPVCSelector     *metav1.LabelSelector
+		expectedBackupPVCAnnotations  map[string]string
+		expectedDNSPolicy             corev1api.DNSPolicy
+		expectedRestartPolicy         corev1api.RestartPolicy
+		expectedVolumes               []corev1api.Volume
+		expectedVolumeMounts          []corev1api.VolumeMount
+		expectedDNSConfig             *corev1api.PodDNSConfig
+		expectedBackupVSCPolicy       snapshotv1api.DeletionPolicy
+		expectedSourceSnapshotKept    bool
+		expectedGracePeriod           *int64
+		expectedRuntimeClass          *string
+		expectedVolumeAttributesClass *string
+		expectedEvents                []string
+		expectedErrKinds              []error
 	}{
 		{
 			name:        "wait vs ready fail",
@@ -174,7 +282,34 @@ func TestExpose(t *testing.T) {
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
 			},
-			err: "error wait volume snapshot ready: error to get VolumeSnapshot /fake-vs: volumesnapshots.snapshot.storage.k8s.io \"fake-vs\" not found",
+			err:              "error wait volume snapshot ready: error to get VolumeSnapshot /fake-vs: volumesnapshots.snapshot.storage.k8s.io \"fake-vs\" not found",
+			expectedErrKinds: []error{ErrSnapshotNotReady},
+		},
+		{
+			name:        "vs reports permanent error",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Hour,
+			},
+			snapshotClientObj: []runtime.Object{
+				&snapshotv1api.VolumeSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-vs",
+						Namespace: "fake-ns",
+					},
+					Status: &snapshotv1api.VolumeSnapshotStatus{
+						ReadyToUse: boolptr.False(),
+						Error: &snapshotv1api.VolumeSnapshotError{
+							Message: pointer.String("fake-snapshot-error"),
+						},
+					},
+				},
+			},
+			err:              "error wait volume snapshot ready: VolumeSnapshot fake-ns/fake-vs failed: fake-snapshot-error",
+			expectedErrKinds: []error{ErrSnapshotNotReady, ErrSnapshotFailed},
 		},
 		{
 			name:        "get vsc fail",
@@ -188,7 +323,8 @@ func TestExpose(t *testing.T) {
 			snapshotClientObj: []runtime.Object{
 				vsObject,
 			},
-			err: "error to get volume snapshot content: error getting volume snapshot content from API: volumesnapshotcontents.snapshot.storage.k8s.io \"fake-vsc\" not found",
+			err:              "error to get volume snapshot content: error getting volume snapshot content from API: volumesnapshotcontents.snapshot.storage.k8s.io \"fake-vsc\" not found",
+			expectedErrKinds: []error{ErrSnapshotContentNotFound},
 		},
 		{
 			name:        "delete vs fail",
@@ -212,7 +348,8 @@ func TestExpose(t *testing.T) {
 					},
 				},
 			},
-			err: "error to delete volume snapshot: error to delete volume snapshot: fake-delete-error",
+			err:              "error to delete volume snapshot: error to delete volume snapshot: fake-delete-error",
+			expectedErrKinds: []error{ErrSourceSnapshotCleanupFailed},
 		},
 		{
 			name:        "delete vsc fail",
@@ -260,7 +397,8 @@ func TestExpose(t *testing.T) {
 					},
 				},
 			},
-			err: "error to create backup volume snapshot: fake-create-error",
+			err:              "error to create backup volume snapshot: fake-create-error",
+			expectedErrKinds: []error{ErrBackupSnapshotCreateFailed},
 		},
 		{
 			name:        "create backup vsc fail",
@@ -298,7 +436,8 @@ func TestExpose(t *testing.T) {
 				vsObject,
 				vscObj,
 			},
-			err: "error to create backup pvc: unsupported access mode fake-mode",
+			err:              "error to create backup pvc: unsupported access mode fake-mode",
+			expectedErrKinds: []error{ErrBackupPVCCreateFailed, ErrUnsupportedAccessMode},
 		},
 		{
 			name:        "create backup pvc fail",
@@ -324,6 +463,11 @@ func TestExpose(t *testing.T) {
 				},
 			},
 			err: "error to create backup pvc: error to create pvc: fake-create-error",
+			expectedEvents: []string{
+				"Normal Expose-Snapshot-Ready VolumeSnapshot fake-ns/fake-vs is ready",
+				"Normal Expose-Backup-VS-Created Backup VS velero/fake-backup is created from fake-ns/fake-vs",
+				"Warning Expose-Failed Failed to expose snapshot fake-ns/fake-vs: error to create backup pvc: error to create pvc: fake-create-error",
+			},
 		},
 		{
 			name:        "create backup pod fail",
@@ -351,7 +495,8 @@ func TestExpose(t *testing.T) {
 					},
 				},
 			},
-			err: "error to create backup pod: fake-create-error",
+			err:              "error to create backup pod: fake-create-error",
+			expectedErrKinds: []error{ErrBackupPodCreateFailed},
 		},
 		{
 			name:        "success",
@@ -370,9 +515,13 @@ func TestExpose(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				daemonSet,
 			},
+			expectedEvents: []string{
+				"Normal Expose-Snapshot-Ready VolumeSnapshot fake-ns/fake-vs is ready",
+				"Normal Expose-Backup-VS-Created Backup VS velero/fake-backup is created from fake-ns/fake-vs",
+			},
 		},
 		{
-			name:        "restore size from exposeParam",
+			name:        "runtime class",
 			ownerBackup: backup,
 			exposeParam: CSISnapshotExposeParam{
 				SnapshotName:     "fake-vs",
@@ -380,34 +529,75 @@ func TestExpose(t *testing.T) {
 				AccessMode:       AccessModeFileSystem,
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
-				VolumeSize:       *resource.NewQuantity(567890, ""),
+				RuntimeClassName: pointer.String("kata"),
 			},
 			snapshotClientObj: []runtime.Object{
-				vsObjectWithoutRestoreSize,
+				vsObject,
 				vscObj,
 			},
 			kubeClientObj: []runtime.Object{
 				daemonSet,
+				&nodev1api.RuntimeClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "kata",
+					},
+					Handler: "kata",
+				},
+			},
+			expectedRuntimeClass: pointer.String("kata"),
+			expectedEvents: []string{
+				"Normal Expose-Snapshot-Ready VolumeSnapshot fake-ns/fake-vs is ready",
+				"Normal Expose-Backup-VS-Created Backup VS velero/fake-backup is created from fake-ns/fake-vs",
 			},
-			expectedVolumeSize: resource.NewQuantity(567890, ""),
 		},
 		{
-			name:        "backupPod mounts read only backupPVC",
+			name:        "runtime class without block device passthrough",
 			ownerBackup: backup,
 			exposeParam: CSISnapshotExposeParam{
 				SnapshotName:     "fake-vs",
 				SourceNamespace:  "fake-ns",
-				StorageClass:     "fake-sc",
-				AccessMode:       AccessModeFileSystem,
+				AccessMode:       AccessModeBlock,
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
-				BackupPVCConfig: map[string]nodeagent.BackupPVC{
-					"fake-sc": {
-						StorageClass: "fake-sc-read-only",
-						ReadOnly:     true,
+				RuntimeClassName: pointer.String("gvisor"),
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&nodev1api.RuntimeClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "gvisor",
 					},
+					Handler: "runsc",
 				},
 			},
+			expectedVolumeDevices: []corev1api.VolumeDevice{
+				{
+					Name:       string(backup.UID),
+					DevicePath: "/" + string(backup.UID),
+				},
+			},
+			expectedRuntimeClass: pointer.String("gvisor"),
+			expectedEvents: []string{
+				"Warning Expose-Runtime-Class-Incompatible Handler runsc of runtime class gvisor may not support block device passthrough required by access mode by-block-device",
+				"Normal Expose-Snapshot-Ready VolumeSnapshot fake-ns/fake-vs is ready",
+				"Normal Expose-Backup-VS-Created Backup VS velero/fake-backup is created from fake-ns/fake-vs",
+			},
+		},
+		{
+			name:        "runtime class doesn't exist",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				RuntimeClassName: pointer.String("kata"),
+			},
 			snapshotClientObj: []runtime.Object{
 				vsObject,
 				vscObj,
@@ -415,24 +605,66 @@ func TestExpose(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				daemonSet,
 			},
-			expectedReadOnlyPVC: true,
+			err:              "runtime class kata for backup pod doesn't exist",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
 		},
 		{
-			name:        "backupPod mounts read only backupPVC and storageClass specified in backupPVC config",
+			name:        "sidecar injection is disabled by default",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:          "fake-vs",
+				SourceNamespace:       "fake-ns",
+				AccessMode:            AccessModeFileSystem,
+				OperationTimeout:      time.Millisecond,
+				ExposeTimeout:         time.Millisecond,
+				HostingPodAnnotations: map[string]string{"fake-key": "fake-value"},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedPodAnnotations: map[string]string{
+				"fake-key":                  "fake-value",
+				"sidecar.istio.io/inject":   "false",
+				"linkerd.io/inject":         "disabled",
+				"kuma.io/sidecar-injection": "disabled",
+			},
+		},
+		{
+			name:        "sidecar injection is allowed",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:          "fake-vs",
+				SourceNamespace:       "fake-ns",
+				AccessMode:            AccessModeFileSystem,
+				OperationTimeout:      time.Millisecond,
+				ExposeTimeout:         time.Millisecond,
+				HostingPodAnnotations: map[string]string{"fake-key": "fake-value"},
+				AllowSidecarInjection: true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedPodAnnotations: map[string]string{
+				"fake-key": "fake-value",
+			},
+		},
+		{
+			name:        "backup pod runs as root by default",
 			ownerBackup: backup,
 			exposeParam: CSISnapshotExposeParam{
 				SnapshotName:     "fake-vs",
 				SourceNamespace:  "fake-ns",
-				StorageClass:     "fake-sc",
 				AccessMode:       AccessModeFileSystem,
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
-				BackupPVCConfig: map[string]nodeagent.BackupPVC{
-					"fake-sc": {
-						StorageClass: "fake-sc-read-only",
-						ReadOnly:     true,
-					},
-				},
 			},
 			snapshotClientObj: []runtime.Object{
 				vsObject,
@@ -441,24 +673,21 @@ func TestExpose(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				daemonSet,
 			},
-			expectedReadOnlyPVC:           true,
-			expectedBackupPVCStorageClass: "fake-sc-read-only",
+			expectedSecurityContext: &corev1api.PodSecurityContext{
+				RunAsUser: pointer.Int64(0),
+			},
 		},
 		{
-			name:        "backupPod mounts backupPVC with storageClass specified in backupPVC config",
+			name:        "backup pod runs as non root",
 			ownerBackup: backup,
 			exposeParam: CSISnapshotExposeParam{
 				SnapshotName:     "fake-vs",
 				SourceNamespace:  "fake-ns",
-				StorageClass:     "fake-sc",
 				AccessMode:       AccessModeFileSystem,
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
-				BackupPVCConfig: map[string]nodeagent.BackupPVC{
-					"fake-sc": {
-						StorageClass: "fake-sc-read-only",
-					},
-				},
+				RunAsNonRoot:     true,
+				RunAsUserID:      pointer.Int64(1000),
 			},
 			snapshotClientObj: []runtime.Object{
 				vsObject,
@@ -467,30 +696,22 @@ func TestExpose(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				daemonSet,
 			},
-			expectedBackupPVCStorageClass: "fake-sc-read-only",
+			expectedSecurityContext: &corev1api.PodSecurityContext{
+				RunAsNonRoot: boolptr.True(),
+				RunAsUser:    pointer.Int64(1000),
+			},
 		},
 		{
-			name:        "Affinity per StorageClass",
+			name:        "backup pod restarts on failure",
 			ownerBackup: backup,
 			exposeParam: CSISnapshotExposeParam{
 				SnapshotName:     "fake-vs",
 				SourceNamespace:  "fake-ns",
-				StorageClass:     "fake-sc",
 				AccessMode:       AccessModeFileSystem,
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
-				Affinity: &kube.LoadAffinity{
-					NodeSelector: metav1.LabelSelector{
-						MatchExpressions: []metav1.LabelSelectorRequirement{
-							{
-								Key:      "kubernetes.io/os",
-								Operator: metav1.LabelSelectorOpIn,
-								Values:   []string{"Linux"},
-							},
-						},
-					},
-					StorageClass: "fake-sc",
-				},
+				PodRestartPolicy: corev1api.RestartPolicyOnFailure,
+				PodRestartLimit:  3,
 			},
 			snapshotClientObj: []runtime.Object{
 				vsObject,
@@ -499,51 +720,24 @@ func TestExpose(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				daemonSet,
 			},
-			expectedAffinity: &corev1api.Affinity{
-				NodeAffinity: &corev1api.NodeAffinity{
-					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
-						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
-							{
-								MatchExpressions: []corev1api.NodeSelectorRequirement{
-									{
-										Key:      "kubernetes.io/os",
-										Operator: corev1api.NodeSelectorOpIn,
-										Values:   []string{"Linux"},
-									},
-								},
-							},
-						},
-					},
-				},
+			expectedRestartPolicy: corev1api.RestartPolicyOnFailure,
+			expectedPodAnnotations: map[string]string{
+				"velero.io/restart-limit":   "3",
+				"sidecar.istio.io/inject":   "false",
+				"linkerd.io/inject":         "disabled",
+				"kuma.io/sidecar-injection": "disabled",
 			},
 		},
 		{
-			name:        "Affinity per StorageClass with expectedBackupPVCStorageClass",
+			name:        "backup pod never restarts by default",
 			ownerBackup: backup,
 			exposeParam: CSISnapshotExposeParam{
 				SnapshotName:     "fake-vs",
 				SourceNamespace:  "fake-ns",
-				StorageClass:     "fake-sc",
 				AccessMode:       AccessModeFileSystem,
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
-				BackupPVCConfig: map[string]nodeagent.BackupPVC{
-					"fake-sc": {
-						StorageClass: "fake-sc-read-only",
-					},
-				},
-				Affinity: &kube.LoadAffinity{
-					NodeSelector: metav1.LabelSelector{
-						MatchExpressions: []metav1.LabelSelectorRequirement{
-							{
-								Key:      "kubernetes.io/arch",
-								Operator: metav1.LabelSelectorOpIn,
-								Values:   []string{"amd64"},
-							},
-						},
-					},
-					StorageClass: "fake-sc-read-only",
-				},
+				PodRestartLimit:  3,
 			},
 			snapshotClientObj: []runtime.Object{
 				vsObject,
@@ -552,41 +746,128 @@ func TestExpose(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				daemonSet,
 			},
-			expectedBackupPVCStorageClass: "fake-sc-read-only",
-			expectedAffinity: &corev1api.Affinity{
-				NodeAffinity: &corev1api.NodeAffinity{
-					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
-						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
-							{
-								MatchExpressions: []corev1api.NodeSelectorRequirement{
-									{
-										Key:      "kubernetes.io/arch",
-										Operator: corev1api.NodeSelectorOpIn,
-										Values:   []string{"amd64"},
-									},
-								},
-							},
-						},
-					},
-				},
+			expectedRestartPolicy: corev1api.RestartPolicyNever,
+			expectedPodAnnotations: map[string]string{
+				"sidecar.istio.io/inject":   "false",
+				"linkerd.io/inject":         "disabled",
+				"kuma.io/sidecar-injection": "disabled",
 			},
 		},
 		{
-			name:        "Affinity in exposeParam is nil",
+			name:        "backup pod with termination grace period",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				OperationTimeout:          time.Millisecond,
+				ExposeTimeout:             time.Millisecond,
+				PodTerminationGracePeriod: pointer.Int64(30),
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedGracePeriod: pointer.Int64(30),
+		},
+		{
+			name:        "invalid termination grace period",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				OperationTimeout:          time.Millisecond,
+				ExposeTimeout:             time.Millisecond,
+				PodTerminationGracePeriod: pointer.Int64(-1),
+			},
+			err:              "invalid termination grace period -1 of backup pod",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "unsupported restart policy",
 			ownerBackup: backup,
 			exposeParam: CSISnapshotExposeParam{
 				SnapshotName:     "fake-vs",
 				SourceNamespace:  "fake-ns",
-				StorageClass:     "fake-sc",
 				AccessMode:       AccessModeFileSystem,
 				OperationTimeout: time.Millisecond,
 				ExposeTimeout:    time.Millisecond,
-				BackupPVCConfig: map[string]nodeagent.BackupPVC{
-					"fake-sc": {
-						StorageClass: "fake-sc-read-only",
+				PodRestartPolicy: corev1api.RestartPolicyAlways,
+			},
+			err:              "unsupported restart policy Always of backup pod",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "unsupported image pull policy",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				ImagePullPolicy:  "fake-policy",
+			},
+			err:              "unsupported image pull policy fake-policy of backup pod",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "unsupported direction",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				Direction:        "fake-direction",
+			},
+			err:              "unsupported expose direction fake-direction",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "invalid restart limit",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				PodRestartPolicy: corev1api.RestartPolicyOnFailure,
+				PodRestartLimit:  -1,
+			},
+			err:              "invalid restart limit -1 of backup pod",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "backup pod with extra volumes",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				ExtraVolumes: []corev1api.Volume{
+					{
+						Name: "fake-credentials",
+						VolumeSource: corev1api.VolumeSource{
+							HostPath: &corev1api.HostPathVolumeSource{Path: "/etc/fake-credentials"},
+						},
+					},
+				},
+				ExtraVolumeMounts: []corev1api.VolumeMount{
+					{
+						Name:      "fake-credentials",
+						MountPath: "/credentials",
+						ReadOnly:  true,
 					},
 				},
-				Affinity: nil,
 			},
 			snapshotClientObj: []runtime.Object{
 				vsObject,
@@ -595,894 +876,9001 @@ func TestExpose(t *testing.T) {
 			kubeClientObj: []runtime.Object{
 				daemonSet,
 			},
-			expectedBackupPVCStorageClass: "fake-sc-read-only",
-			expectedAffinity:              nil,
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			fakeSnapshotClient := snapshotFake.NewSimpleClientset(test.snapshotClientObj...)
-			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
-
-			for _, reactor := range test.snapReactors {
-				fakeSnapshotClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
-			}
-
-			for _, reactor := range test.kubeReactors {
-				fakeKubeClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
-			}
-
-			exposer := csiSnapshotExposer{
-				kubeClient:        fakeKubeClient,
-				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
-				log:               velerotest.NewLogger(),
-			}
-
-			var ownerObject corev1api.ObjectReference
-			if test.ownerBackup != nil {
-				ownerObject = corev1api.ObjectReference{
-					Kind:       test.ownerBackup.Kind,
-					Namespace:  test.ownerBackup.Namespace,
-					Name:       test.ownerBackup.Name,
-					UID:        test.ownerBackup.UID,
-					APIVersion: test.ownerBackup.APIVersion,
-				}
-			}
-
-			err := exposer.Expose(context.Background(), ownerObject, &test.exposeParam)
-			if err == nil {
-				require.NoError(t, err)
-
-				backupPod, err := exposer.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
-				require.NoError(t, err)
-
-				backupPVC, err := exposer.kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
-				require.NoError(t, err)
-
-				expectedVS, err := exposer.csiSnapshotClient.VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
-				require.NoError(t, err)
-
-				expectedVSC, err := exposer.csiSnapshotClient.VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
-				require.NoError(t, err)
-
-				assert.Equal(t, expectedVS.Annotations, vsObject.Annotations)
-				assert.Equal(t, *expectedVS.Spec.VolumeSnapshotClassName, *vsObject.Spec.VolumeSnapshotClassName)
-				assert.Equal(t, expectedVSC.Name, *expectedVS.Spec.Source.VolumeSnapshotContentName)
-
-				assert.Equal(t, expectedVSC.Annotations, vscObj.Annotations)
-				assert.Equal(t, expectedVSC.Spec.DeletionPolicy, vscObj.Spec.DeletionPolicy)
-				assert.Equal(t, expectedVSC.Spec.Driver, vscObj.Spec.Driver)
-				assert.Equal(t, *expectedVSC.Spec.VolumeSnapshotClassName, *vscObj.Spec.VolumeSnapshotClassName)
-
-				if test.expectedVolumeSize != nil {
-					assert.Equal(t, *test.expectedVolumeSize, backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage])
-				} else {
-					assert.Equal(t, *resource.NewQuantity(restoreSize, ""), backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage])
-				}
-
-				if test.expectedReadOnlyPVC {
-					gotReadOnlyAccessMode := false
-					for _, accessMode := range backupPVC.Spec.AccessModes {
-						if accessMode == corev1api.ReadOnlyMany {
-							gotReadOnlyAccessMode = true
-						}
-					}
-					assert.Equal(t, test.expectedReadOnlyPVC, gotReadOnlyAccessMode)
-				}
-
-				if test.expectedBackupPVCStorageClass != "" {
-					assert.Equal(t, test.expectedBackupPVCStorageClass, *backupPVC.Spec.StorageClassName)
-				}
-
-				if test.expectedAffinity != nil {
-					assert.Equal(t, test.expectedAffinity, backupPod.Spec.Affinity)
-				}
-			} else {
-				assert.EqualError(t, err, test.err)
-			}
-		})
-	}
-}
-
-func TestGetExpose(t *testing.T) {
-	backup := &velerov1.Backup{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: velerov1.SchemeGroupVersion.String(),
-			Kind:       "Backup",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			UID:       "fake-uid",
-		},
-	}
-
-	backupPod := &corev1api.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: backup.Namespace,
-			Name:      backup.Name,
-		},
-		Spec: corev1api.PodSpec{
-			Volumes: []corev1api.Volume{
+			expectedVolumes: []corev1api.Volume{
 				{
-					Name: "fake-volume",
+					Name: "fake-uid",
+					VolumeSource: corev1api.VolumeSource{
+						PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
+							ClaimName: "fake-backup",
+						},
+					},
 				},
 				{
-					Name: "fake-volume-2",
+					Name: "fake-credentials",
+					VolumeSource: corev1api.VolumeSource{
+						HostPath: &corev1api.HostPathVolumeSource{Path: "/etc/fake-credentials"},
+					},
 				},
+			},
+			expectedVolumeMounts: []corev1api.VolumeMount{
 				{
-					Name: string(backup.UID),
+					Name:      "fake-uid",
+					MountPath: "/fake-uid",
+				},
+				{
+					Name:      "fake-credentials",
+					MountPath: "/credentials",
+					ReadOnly:  true,
 				},
 			},
 		},
-	}
-
-	backupPodWithoutVolume := &corev1api.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: backup.Namespace,
-			Name:      backup.Name,
+		{
+			name:        "extra volume mount collides with the backup volume",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				ExtraVolumeMounts: []corev1api.VolumeMount{
+					{
+						Name:      "fake-credentials",
+						MountPath: "/fake-uid/credentials",
+					},
+				},
+			},
+			err:              "mount path /fake-uid/credentials of extra volume fake-credentials conflicts with the backup volume path /fake-uid",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
 		},
-		Spec: corev1api.PodSpec{
-			Volumes: []corev1api.Volume{
+		{
+			name:        "owner without UID",
+			ownerBackup: backupWithoutUID,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			err:              "owner velero/fake-backup has no UID and no name source is specified to name the backup container and volume",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "owner without UID, with name source",
+			ownerBackup: backupWithoutUID,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				NameSource:       "fake-name-source",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedVolumes: []corev1api.Volume{
 				{
-					Name: "fake-volume-1",
+					Name: "fake-name-source",
+					VolumeSource: corev1api.VolumeSource{
+						PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
+							ClaimName: "fake-backup",
+						},
+					},
 				},
+			},
+			expectedVolumeMounts: []corev1api.VolumeMount{
 				{
-					Name: "fake-volume-2",
+					Name:      "fake-name-source",
+					MountPath: "/fake-name-source",
 				},
 			},
 		},
-	}
-
-	backupPVC := &corev1api.PersistentVolumeClaim{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: backup.Namespace,
-			Name:      backup.Name,
-		},
-		Spec: corev1api.PersistentVolumeClaimSpec{
-			VolumeName: "fake-pv-name",
+		{
+			name:        "invalid name source",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				NameSource:       "Fake_Name",
+			},
+			err: "invalid name Fake_Name for the backup container and volume: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', " +
+				"and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
 		},
-	}
-
-	backupPV := &corev1api.PersistentVolume{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "fake-pv-name",
+		{
+			name:        "run as non root with root user ID",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				RunAsNonRoot:     true,
+				RunAsUserID:      pointer.Int64(0),
+			},
+			err:              "runAsNonRoot is requested with root user ID",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
 		},
-	}
-
-	scheme := runtime.NewScheme()
-	corev1api.AddToScheme(scheme)
-
-	tests := []struct {
-		name            string
-		kubeClientObj   []runtime.Object
-		ownerBackup     *velerov1.Backup
-		exposeWaitParam CSISnapshotExposeWaitParam
-		Timeout         time.Duration
-		err             string
-		expectedResult  *ExposeResult
-	}{
 		{
-			name:        "backup pod is not found",
+			name:        "run as non root with spcNoRelabeling",
 			ownerBackup: backup,
-			exposeWaitParam: CSISnapshotExposeWaitParam{
-				NodeName: "fake-node",
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				RunAsNonRoot:     true,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						ReadOnly:        true,
+						SPCNoRelabeling: true,
+					},
+				},
 			},
+			err: "spcNoRelabeling is not compatible with runAsNonRoot",
 		},
 		{
-			name:        "wait pvc bound fail",
+			name:        "node OS is selected by default label",
 			ownerBackup: backup,
-			exposeWaitParam: CSISnapshotExposeWaitParam{
-				NodeName: "fake-node",
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
 			},
 			kubeClientObj: []runtime.Object{
-				backupPod,
+				daemonSet,
+			},
+			expectedNodeSelector: map[string]string{
+				kube.NodeOSLabel: kube.NodeOSLinux,
 			},
-			Timeout: time.Second,
-			err:     "error to wait backup PVC bound, fake-backup: error to wait for rediness of PVC: error to get pvc velero/fake-backup: persistentvolumeclaims \"fake-backup\" not found",
 		},
 		{
-			name:        "backup volume not found in pod",
+			name:        "node OS is selected by custom labels",
 			ownerBackup: backup,
-			exposeWaitParam: CSISnapshotExposeWaitParam{
-				NodeName: "fake-node",
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				NodeOSLabelKeys:  []string{"example.io/os", "example.io/platform"},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
 			},
 			kubeClientObj: []runtime.Object{
-				backupPodWithoutVolume,
-				backupPVC,
-				backupPV,
+				daemonSet,
+			},
+			expectedNodeSelector: map[string]string{
+				"example.io/os":       kube.NodeOSLinux,
+				"example.io/platform": kube.NodeOSLinux,
 			},
-			Timeout: time.Second,
-			err:     "backup pod fake-backup doesn't have the expected backup volume",
 		},
 		{
-			name:        "succeed",
+			name:        "restore size from exposeParam",
 			ownerBackup: backup,
-			exposeWaitParam: CSISnapshotExposeWaitParam{
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				VolumeSize:       *resource.NewQuantity(567890, ""),
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObjectWithoutRestoreSize,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedVolumeSize: resource.NewQuantity(567890, ""),
+		},
+		{
+			name:        "leftover backup pvc of another owner",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&corev1api.PersistentVolumeClaim{ObjectMeta: leftoverObjectMeta("old-uid")},
+			},
+			err:              "backup pvc velero/fake-backup already exists and is controlled by owner UID old-uid other than fake-uid, delete it to retry",
+			expectedErrKinds: []error{ErrExposeConflict},
+		},
+		{
+			name:        "leftover backup pvc of the same owner is adopted",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&corev1api.PersistentVolumeClaim{
+					ObjectMeta: leftoverObjectMeta("fake-uid"),
+					Spec: corev1api.PersistentVolumeClaimSpec{
+						DataSource: &corev1api.TypedLocalObjectReference{
+							Kind: "VolumeSnapshot",
+							Name: "fake-backup",
+						},
+						Resources: corev1api.VolumeResourceRequirements{
+							Requests: corev1api.ResourceList{
+								corev1api.ResourceStorage: *resource.NewQuantity(restoreSize, ""),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "leftover backup pod of the same owner is recreated",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&corev1api.Pod{ObjectMeta: leftoverObjectMeta("fake-uid")},
+			},
+		},
+		{
+			name:        "leftover backup pod of another owner",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:           "fake-vs",
+				SourceNamespace:        "fake-ns",
+				AccessMode:             AccessModeFileSystem,
+				OperationTimeout:       time.Millisecond,
+				ExposeTimeout:          time.Millisecond,
+				AdoptExistingBackupPod: true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&corev1api.Pod{ObjectMeta: leftoverObjectMeta("old-uid")},
+			},
+			err:              "backup pod velero/fake-backup already exists and is controlled by owner UID old-uid other than fake-uid, delete it to retry",
+			expectedErrKinds: []error{ErrExposeConflict},
+		},
+		{
+			name:        "source snapshot retain is skipped",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:             "fake-vs",
+				SourceNamespace:          "fake-ns",
+				AccessMode:               AccessModeFileSystem,
+				OperationTimeout:         time.Millisecond,
+				ExposeTimeout:            time.Millisecond,
+				SkipSourceSnapshotRetain: true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			snapReactors: []reactor{
+				{
+					verb:     "patch",
+					resource: "volumesnapshotcontents",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-patch-error")
+					},
+				},
+			},
+			expectedBackupVSCPolicy:    snapshotv1api.VolumeSnapshotContentRetain,
+			expectedSourceSnapshotKept: true,
+		},
+		{
+			name:        "volume size mismatch within tolerance",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:                "fake-vs",
+				SourceNamespace:             "fake-ns",
+				AccessMode:                  AccessModeFileSystem,
+				OperationTimeout:            time.Millisecond,
+				ExposeTimeout:               time.Millisecond,
+				VolumeSize:                  *resource.NewQuantity(130000, ""),
+				VolumeSizeMismatchTolerance: 10,
+				FailOnVolumeSizeMismatch:    true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedVolumeSize: resource.NewQuantity(130000, ""),
+		},
+		{
+			name:        "volume size overhead",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:       "fake-vs",
+				SourceNamespace:    "fake-ns",
+				AccessMode:         AccessModeFileSystem,
+				OperationTimeout:   time.Millisecond,
+				ExposeTimeout:      time.Millisecond,
+				VolumeSizeOverhead: *resource.NewQuantity(1000, ""),
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedVolumeSize: resource.NewQuantity(124456, ""),
+		},
+		{
+			name:        "negative volume size overhead",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:       "fake-vs",
+				SourceNamespace:    "fake-ns",
+				AccessMode:         AccessModeFileSystem,
+				OperationTimeout:   time.Millisecond,
+				ExposeTimeout:      time.Millisecond,
+				VolumeSizeOverhead: resource.MustParse("-1Gi"),
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "invalid volume size overhead -1Gi",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "volume size is rounded up to min volume size",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						MinVolumeSize: *resource.NewQuantity(1073741824, resource.BinarySI),
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedVolumeSize: resource.NewQuantity(1073741824, resource.BinarySI),
+		},
+		{
+			name:        "negative min volume size",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						MinVolumeSize: resource.MustParse("-1Gi"),
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "invalid min volume size -1Gi of storage class fake-sc",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "volume size mismatch beyond tolerance is warned",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:                "fake-vs",
+				SourceNamespace:             "fake-ns",
+				AccessMode:                  AccessModeFileSystem,
+				OperationTimeout:            time.Millisecond,
+				ExposeTimeout:               time.Millisecond,
+				VolumeSize:                  *resource.NewQuantity(200000, ""),
+				VolumeSizeMismatchTolerance: 10,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedVolumeSize: resource.NewQuantity(200000, ""),
+		},
+		{
+			name:        "volume size mismatch beyond tolerance fails",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:                "fake-vs",
+				SourceNamespace:             "fake-ns",
+				AccessMode:                  AccessModeFileSystem,
+				OperationTimeout:            time.Millisecond,
+				ExposeTimeout:               time.Millisecond,
+				VolumeSize:                  *resource.NewQuantity(200000, ""),
+				VolumeSizeMismatchTolerance: 10,
+				FailOnVolumeSizeMismatch:    true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "restore size 123456 of the snapshot differs from the source volume size 200e3 by 38.3%, exceeding the tolerance 10%",
+			expectedErrKinds: []error{ErrVolumeSizeMismatch},
+		},
+		{
+			name:        "backupPod mounts read only backupPVC",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-sc-read-only",
+						ReadOnly:     true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedReadOnlyPVC: true,
+		},
+		{
+			name:        "backupPVC with volume attributes class",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						BackupPVCVolumeAttributesClass: pointer.String("fake-low-qos"),
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			kubeAPIResources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "storage.k8s.io/v1beta1",
+					APIResources: []metav1.APIResource{
+						{Name: "volumeattributesclasses", Kind: "VolumeAttributesClass"},
+					},
+				},
+			},
+			expectedBackupPVCStorageClass: "fake-sc",
+			expectedVolumeAttributesClass: pointer.String("fake-low-qos"),
+		},
+		{
+			name:        "volume attributes class is ignored when not supported",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						BackupPVCVolumeAttributesClass: pointer.String("fake-low-qos"),
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			kubeAPIResources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "storage.k8s.io/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "storageclasses", Kind: "StorageClass"},
+					},
+				},
+			},
+			expectedBackupPVCStorageClass: "fake-sc",
+		},
+		{
+			name:        "backup volume snapshot class not found",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				OperationTimeout:          time.Millisecond,
+				ExposeTimeout:             time.Millisecond,
+				BackupVolumeSnapshotClass: "fake-backup-vs-class",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			err:              "volume snapshot class fake-backup-vs-class for backup VS doesn't exist",
+			expectedErrKinds: []error{ErrInvalidExposeParam, ErrSnapshotClassNotFound},
+		},
+		{
+			name:        "backup volume snapshot class overridden",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				OperationTimeout:          time.Millisecond,
+				ExposeTimeout:             time.Millisecond,
+				BackupVolumeSnapshotClass: "fake-backup-vs-class",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+				&snapshotv1api.VolumeSnapshotClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-backup-vs-class",
+					},
+				},
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupVSClass: "fake-backup-vs-class",
+		},
+		{
+			name:        "source vs class deleted",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			sourceVSClassDeleted: true,
+			err:                  "volume snapshot class fake-snapshot-class of snapshot fake-ns/fake-vs doesn't exist, specify a default volume snapshot class to fall back",
+			expectedErrKinds:     []error{ErrBackupSnapshotCreateFailed, ErrSnapshotClassNotFound},
+		},
+		{
+			name:        "source vs class deleted, fall back to default class",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:               "fake-vs",
+				SourceNamespace:            "fake-ns",
+				AccessMode:                 AccessModeFileSystem,
+				OperationTimeout:           time.Millisecond,
+				ExposeTimeout:              time.Millisecond,
+				DefaultVolumeSnapshotClass: "fake-default-vs-class",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+				&snapshotv1api.VolumeSnapshotClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-default-vs-class",
+					},
+				},
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			sourceVSClassDeleted:  true,
+			expectedBackupVSClass: "fake-default-vs-class",
+		},
+		{
+			name:        "source vs class exists, default class is not used",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:               "fake-vs",
+				SourceNamespace:            "fake-ns",
+				AccessMode:                 AccessModeFileSystem,
+				OperationTimeout:           time.Millisecond,
+				ExposeTimeout:              time.Millisecond,
+				DefaultVolumeSnapshotClass: "fake-default-vs-class",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+				&snapshotv1api.VolumeSnapshotClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-default-vs-class",
+					},
+				},
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+		},
+		{
+			name:        "default vs class doesn't exist",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:               "fake-vs",
+				SourceNamespace:            "fake-ns",
+				AccessMode:                 AccessModeFileSystem,
+				OperationTimeout:           time.Millisecond,
+				ExposeTimeout:              time.Millisecond,
+				DefaultVolumeSnapshotClass: "fake-default-vs-class",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "volume snapshot class fake-default-vs-class for backup VS doesn't exist",
+			expectedErrKinds: []error{ErrInvalidExposeParam, ErrSnapshotClassNotFound},
+		},
+		{
+			name:        "storage class max size not set",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:           "fake-vs",
+				SourceNamespace:        "fake-ns",
+				StorageClass:           "fake-sc",
+				AccessMode:             AccessModeFileSystem,
+				OperationTimeout:       time.Millisecond,
+				ExposeTimeout:          time.Millisecond,
+				StorageClassMaxSizeKey: "example.com/max-size",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&storagev1api.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-sc",
+					},
+				},
+			},
+		},
+		{
+			name:        "storage class max size in annotation not exceeded",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:           "fake-vs",
+				SourceNamespace:        "fake-ns",
+				StorageClass:           "fake-sc",
+				AccessMode:             AccessModeFileSystem,
+				OperationTimeout:       time.Millisecond,
+				ExposeTimeout:          time.Millisecond,
+				StorageClassMaxSizeKey: "example.com/max-size",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&storagev1api.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "fake-sc",
+						Annotations: map[string]string{"example.com/max-size": "1Mi"},
+					},
+				},
+			},
+		},
+		{
+			name:        "storage class max size in parameter exceeded",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:           "fake-vs",
+				SourceNamespace:        "fake-ns",
+				StorageClass:           "fake-sc",
+				AccessMode:             AccessModeFileSystem,
+				OperationTimeout:       time.Millisecond,
+				ExposeTimeout:          time.Millisecond,
+				StorageClassMaxSizeKey: "example.com/max-size",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&storagev1api.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-sc",
+					},
+					Parameters: map[string]string{"example.com/max-size": "100Ki"},
+				},
+			},
+			err:              "size 123456 of backup PVC exceeds the max size 100Ki allowed by storage class fake-sc",
+			expectedErrKinds: []error{ErrVolumeSizeExceedsMax},
+		},
+		{
+			name:        "source snapshot labels preserved",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObjectWithLabels,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupSnapshotLabels: map[string]string{
+				"cost-center": "fake-cost-center",
+			},
+		},
+		{
+			name:        "source snapshot labels not preserved",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:           "fake-vs",
+				SourceNamespace:        "fake-ns",
+				AccessMode:             AccessModeFileSystem,
+				OperationTimeout:       time.Millisecond,
+				ExposeTimeout:          time.Millisecond,
+				PreserveSnapshotLabels: boolptr.False(),
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObjectWithLabels,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+		},
+		{
+			name:        "ephemeral-storage request exceeds limit",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				Resources: corev1api.ResourceRequirements{
+					Requests: corev1api.ResourceList{
+						corev1api.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+					},
+					Limits: corev1api.ResourceList{
+						corev1api.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "ephemeral-storage request 2Gi is larger than limit 1Gi",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "ephemeral-storage resources are honored",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				Resources: corev1api.ResourceRequirements{
+					Requests: corev1api.ResourceList{
+						corev1api.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+					},
+					Limits: corev1api.ResourceList{
+						corev1api.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedResources: &corev1api.ResourceRequirements{
+				Requests: corev1api.ResourceList{
+					corev1api.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+				},
+				Limits: corev1api.ResourceList{
+					corev1api.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+				},
+			},
+		},
+		{
+			name:        "backup vs is never bound",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:          "fake-vs",
+				SourceNamespace:       "fake-ns",
+				AccessMode:            AccessModeFileSystem,
+				OperationTimeout:      time.Millisecond,
+				ExposeTimeout:         time.Millisecond,
+				VerifyBackupVSBinding: true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "timeout to wait backup VS fake-backup bound to VSC fake-backup",
+			expectedErrKinds: []error{ErrBackupSnapshotCreateFailed},
+		},
+		{
+			name:        "snapshot metadata env injected",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				OperationTimeout:          time.Millisecond,
+				ExposeTimeout:             time.Millisecond,
+				InjectSnapshotMetadataEnv: true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedEnv: []corev1api.EnvVar{
+				{Name: EnvSnapshotDriver, Value: "fake-driver"},
+				{Name: EnvSnapshotClass, Value: snapshotClass},
+				{Name: EnvRestoreSize, Value: "123456"},
+				{Name: EnvSourceNamespace, Value: "fake-ns"},
+			},
+		},
+		{
+			name:        "snapshot metadata env injected with backup volume snapshot class",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				OperationTimeout:          time.Millisecond,
+				ExposeTimeout:             time.Millisecond,
+				InjectSnapshotMetadataEnv: true,
+				BackupVolumeSnapshotClass: "fake-backup-vs-class",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+				&snapshotv1api.VolumeSnapshotClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-backup-vs-class",
+					},
+				},
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupVSClass: "fake-backup-vs-class",
+			expectedEnv: []corev1api.EnvVar{
+				{Name: EnvSnapshotDriver, Value: "fake-driver"},
+				{Name: EnvSnapshotClass, Value: "fake-backup-vs-class"},
+				{Name: EnvRestoreSize, Value: "123456"},
+				{Name: EnvSourceNamespace, Value: "fake-ns"},
+			},
+		},
+		{
+			name:        "backupPod with active deadline",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:      "fake-vs",
+				SourceNamespace:   "fake-ns",
+				StorageClass:      "fake-sc",
+				AccessMode:        AccessModeFileSystem,
+				OperationTimeout:  time.Millisecond,
+				ExposeTimeout:     time.Millisecond,
+				PodActiveDeadline: 90*time.Minute + 500*time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedActiveDeadlineSeconds: pointer.Int64(5401),
+		},
+		{
+			name:        "backupPod inherits DNS from node-agent",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				dnsDaemonSet,
+			},
+			expectedDNSPolicy: corev1api.DNSClusterFirstWithHostNet,
+			expectedDNSConfig: &corev1api.PodDNSConfig{
+				Nameservers: []string{"10.0.0.10"},
+			},
+		},
+		{
+			name:        "backupPod with DNS override",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				DNSPolicy:        &dnsPolicyNone,
+				DNSConfig: &corev1api.PodDNSConfig{
+					Nameservers: []string{"192.168.1.53"},
+					Searches:    []string{"region-1.example.com"},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				dnsDaemonSet,
+			},
+			expectedDNSPolicy: corev1api.DNSNone,
+			expectedDNSConfig: &corev1api.PodDNSConfig{
+				Nameservers: []string{"192.168.1.53"},
+				Searches:    []string{"region-1.example.com"},
+			},
+		},
+		{
+			name:        "stale backup VSC is rebuilt",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:                  "fake-vs",
+				SourceNamespace:               "fake-ns",
+				AccessMode:                    AccessModeFileSystem,
+				OperationTimeout:              time.Millisecond,
+				ExposeTimeout:                 time.Millisecond,
+				RebuildOnSourceSnapshotChange: true,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+				staleBackupVSC,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+		},
+		{
+			name:        "stale backup VSC is not rebuilt",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+				staleBackupVSC,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "existing backup VSC fake-backup refers to snapshot handle fake-stale-handle, which differs from handle fake-handle of the source snapshot",
+			expectedErrKinds: []error{ErrExposeConflict},
+		},
+		{
+			name:        "static backup PV",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:       "fake-vs",
+				SourceNamespace:    "fake-ns",
+				AccessMode:         AccessModeFileSystem,
+				OperationTimeout:   time.Millisecond,
+				ExposeTimeout:      time.Millisecond,
+				StaticBackupPVName: "fake-static-pv",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedStaticBackupPV: "fake-static-pv",
+		},
+		{
+			name:        "static backup PV already exists",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:       "fake-vs",
+				SourceNamespace:    "fake-ns",
+				AccessMode:         AccessModeFileSystem,
+				OperationTimeout:   time.Millisecond,
+				ExposeTimeout:      time.Millisecond,
+				StaticBackupPVName: "fake-static-pv",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&corev1api.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-static-pv",
+					},
+				},
+			},
+			err:              "error to create static backup pv: persistentvolumes \"fake-static-pv\" already exists",
+			expectedErrKinds: []error{ErrBackupPVCCreateFailed},
+		},
+		{
+			name:        "source PVC selector",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:       "fake-vs",
+				SourceNamespace:    "fake-ns",
+				StorageClass:       "fake-sc",
+				AccessMode:         AccessModeFileSystem,
+				OperationTimeout:   time.Millisecond,
+				ExposeTimeout:      time.Millisecond,
+				StaticBackupPVName: "fake-static-pv",
+				SourcePVCSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"fake-volume-key": "fake-volume-value"}},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&storagev1api.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-sc",
+					},
+					VolumeBindingMode: &immediateBindingMode,
+				},
+			},
+			expectedStaticBackupPV:    "fake-static-pv",
+			expectedBackupPVCSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"fake-volume-key": "fake-volume-value"}},
+		},
+		{
+			name:        "preserve source PVC annotations",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				PreserveSourcePVCAnnotations: []string{
+					"fake-driver.io/encryption-key",
+					"fake-driver.io/not-exist",
+					"velero.io/fake-managed",
+					kube.KubeAnnSelectedNode,
+					"pv.kubernetes.io/bind-completed",
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObjectWithSourcePVC,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				sourcePVC,
+			},
+			expectedBackupPVCAnnotations: map[string]string{
+				"fake-driver.io/encryption-key": "fake-key-ref",
+			},
+		},
+		{
+			name:        "preserve annotations of source PVC not found",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:                 "fake-vs",
+				SourceNamespace:              "fake-ns",
+				AccessMode:                   AccessModeFileSystem,
+				OperationTimeout:             time.Millisecond,
+				ExposeTimeout:                time.Millisecond,
+				PreserveSourcePVCAnnotations: []string{"fake-driver.io/encryption-key"},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObjectWithSourcePVC,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+		},
+		{
+			name:        "get source PVC fail",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:                 "fake-vs",
+				SourceNamespace:              "fake-ns",
+				AccessMode:                   AccessModeFileSystem,
+				OperationTimeout:             time.Millisecond,
+				ExposeTimeout:                time.Millisecond,
+				PreserveSourcePVCAnnotations: []string{"fake-driver.io/encryption-key"},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObjectWithSourcePVC,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				sourcePVC,
+			},
+			kubeReactors: []reactor{
+				{
+					verb:     "get",
+					resource: "persistentvolumeclaims",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						if action.GetNamespace() != "fake-ns" {
+							return false, nil, nil
+						}
+						return true, nil, errors.New("fake-get-error")
+					},
+				},
+			},
+			err: "error to get source PVC fake-ns/fake-source-pvc: fake-get-error",
+		},
+		{
+			name:        "source PVC selector conflicts with data source",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:      "fake-vs",
+				SourceNamespace:   "fake-ns",
+				StorageClass:      "fake-sc",
+				AccessMode:        AccessModeFileSystem,
+				OperationTimeout:  time.Millisecond,
+				ExposeTimeout:     time.Millisecond,
+				SourcePVCSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"fake-volume-key": "fake-volume-value"}},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "selector of source PVC conflicts with the data source of backup PVC, it requires the static backup PV",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "source PVC selector with wait for first consumer binding mode",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:       "fake-vs",
+				SourceNamespace:    "fake-ns",
+				StorageClass:       "fake-sc",
+				AccessMode:         AccessModeFileSystem,
+				OperationTimeout:   time.Millisecond,
+				ExposeTimeout:      time.Millisecond,
+				StaticBackupPVName: "fake-static-pv",
+				SourcePVCSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"fake-volume-key": "fake-volume-value"}},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+				&storagev1api.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-sc",
+					},
+					VolumeBindingMode: &waitForFirstConsumerBindingMode,
+				},
+			},
+			err:              "selector of source PVC requires the Immediate volume binding mode, storage class fake-sc has WaitForFirstConsumer",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "source PVC selector unsatisfiable by static backup PV",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:       "fake-vs",
+				SourceNamespace:    "fake-ns",
+				AccessMode:         AccessModeFileSystem,
+				OperationTimeout:   time.Millisecond,
+				ExposeTimeout:      time.Millisecond,
+				StaticBackupPVName: "fake-static-pv",
+				SourcePVCSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "fake-volume-key", Operator: metav1.LabelSelectorOpExists},
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "selector fake-volume-key of source PVC doesn't match the static backup PV fake-static-pv",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "default topology spread",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedTopologySpread: []corev1api.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       "kubernetes.io/hostname",
+					WhenUnsatisfiable: corev1api.ScheduleAnyway,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							podGroupLabel: podGroupSnapshot,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "custom topology spread",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				HostingPodLabels: map[string]string{"fake-label": "fake-value"},
+				TopologySpread: []corev1api.TopologySpreadConstraint{
+					{
+						MaxSkew:           2,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1api.DoNotSchedule,
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"fake-label": "fake-value"},
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{
+									Key:      podGroupLabel,
+									Operator: metav1.LabelSelectorOpIn,
+									Values:   []string{podGroupSnapshot},
+								},
+							},
+						},
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedTopologySpread: []corev1api.TopologySpreadConstraint{
+				{
+					MaxSkew:           2,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: corev1api.DoNotSchedule,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"fake-label": "fake-value"},
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      podGroupLabel,
+								Operator: metav1.LabelSelectorOpIn,
+								Values:   []string{podGroupSnapshot},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "topology spread doesn't select pod group",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				HostingPodLabels: map[string]string{"fake-label": "fake-value"},
+				TopologySpread: []corev1api.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1api.DoNotSchedule,
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"fake-label": "fake-value"},
+						},
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "topology spread constraint 0 doesn't select backup pods by label velero.io/exposer-pod-group=snapshot-exposer",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "topology spread without label selector",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				TopologySpread: []corev1api.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1api.DoNotSchedule,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			err:              "topology spread constraint 0 has no label selector",
+			expectedErrKinds: []error{ErrInvalidExposeParam},
+		},
+		{
+			name:        "backupPod attaches read only block backupPVC",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeBlock,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						ReadOnly: true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedReadOnlyPVC: true,
+			expectedVolumeDevices: []corev1api.VolumeDevice{
+				{
+					Name:       string(backup.UID),
+					DevicePath: "/" + string(backup.UID),
+				},
+			},
+		},
+		{
+			name:        "backupPod mounts read only backupPVC and storageClass specified in backupPVC config",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-sc-read-only",
+						ReadOnly:     true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedReadOnlyPVC:           true,
+			expectedBackupPVCStorageClass: "fake-sc-read-only",
+		},
+		{
+			name:        "backupPod mounts backupPVC with storageClass specified in backupPVC config",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-sc-read-only",
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupPVCStorageClass: "fake-sc-read-only",
+		},
+		{
+			name:        "Affinity per StorageClass",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				Affinities: []*kube.LoadAffinity{
+					{
+						NodeSelector: metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{
+									Key:      "kubernetes.io/os",
+									Operator: metav1.LabelSelectorOpIn,
+									Values:   []string{"Linux"},
+								},
+							},
+						},
+						StorageClass: "fake-sc",
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedAffinity: &corev1api.Affinity{
+				NodeAffinity: &corev1api.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1api.NodeSelectorRequirement{
+									{
+										Key:      "kubernetes.io/os",
+										Operator: corev1api.NodeSelectorOpIn,
+										Values:   []string{"Linux"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "Affinity per StorageClass with expectedBackupPVCStorageClass",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-sc-read-only",
+					},
+				},
+				Affinities: []*kube.LoadAffinity{
+					{
+						NodeSelector: metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{
+									Key:      "kubernetes.io/arch",
+									Operator: metav1.LabelSelectorOpIn,
+									Values:   []string{"amd64"},
+								},
+							},
+						},
+						StorageClass: "fake-sc-read-only",
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupPVCStorageClass: "fake-sc-read-only",
+			expectedAffinity: &corev1api.Affinity{
+				NodeAffinity: &corev1api.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1api.NodeSelectorRequirement{
+									{
+										Key:      "kubernetes.io/arch",
+										Operator: corev1api.NodeSelectorOpIn,
+										Values:   []string{"amd64"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "multiple affinities",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				Affinities: []*kube.LoadAffinity{
+					{
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"zone": "backup-a"},
+						},
+					},
+					{
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"zone": "backup-b"},
+						},
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedAffinity: &corev1api.Affinity{
+				NodeAffinity: &corev1api.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1api.NodeSelectorRequirement{
+									{
+										Key:      "zone",
+										Operator: corev1api.NodeSelectorOpIn,
+										Values:   []string{"backup-a"},
+									},
+								},
+							},
+							{
+								MatchExpressions: []corev1api.NodeSelectorRequirement{
+									{
+										Key:      "zone",
+										Operator: corev1api.NodeSelectorOpIn,
+										Values:   []string{"backup-b"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "Affinity in exposeParam is nil",
+			ownerBackup: backup,
+			exposeParam: CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				StorageClass:     "fake-sc",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-sc-read-only",
+					},
+				},
+				Affinities: nil,
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				vscObj,
+			},
+			kubeClientObj: []runtime.Object{
+				daemonSet,
+			},
+			expectedBackupPVCStorageClass: "fake-sc-read-only",
+			expectedAffinity:              nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			snapshotClientObj := test.snapshotClientObj
+			if !test.sourceVSClassDeleted {
+				snapshotClientObj = append(snapshotClientObj, &snapshotv1api.VolumeSnapshotClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: snapshotClass,
+					},
+				})
+			}
+
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(snapshotClientObj...)
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+			fakeKubeClient.Resources = test.kubeAPIResources
+
+			for _, reactor := range test.snapReactors {
+				fakeSnapshotClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
+
+			for _, reactor := range test.kubeReactors {
+				fakeKubeClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
+
+			fakeRecorder := record.NewFakeRecorder(10)
+
+			exposer := csiSnapshotExposer{
+				kubeClient:        fakeKubeClient,
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+				eventRecorder:     fakeRecorder,
+			}
+
+			var ownerObject corev1api.ObjectReference
+			if test.ownerBackup != nil {
+				ownerObject = corev1api.ObjectReference{
+					Kind:       test.ownerBackup.Kind,
+					Namespace:  test.ownerBackup.Namespace,
+					Name:       test.ownerBackup.Name,
+					UID:        test.ownerBackup.UID,
+					APIVersion: test.ownerBackup.APIVersion,
+				}
+			}
+
+			err := exposer.Expose(context.Background(), ownerObject, &test.exposeParam)
+			if test.expectedEvents != nil {
+				events := []string{}
+				for len(fakeRecorder.Events) > 0 {
+					events = append(events, <-fakeRecorder.Events)
+				}
+
+				assert.Equal(t, test.expectedEvents, events)
+			}
+
+			if err == nil {
+				require.NoError(t, err)
+
+				backupPod, err := exposer.kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+
+				backupPVC, err := exposer.kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+
+				expectedVS, err := exposer.csiSnapshotClient.VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+
+				expectedVSC, err := exposer.csiSnapshotClient.VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+
+				assert.Equal(t, expectedVS.Annotations, vsObject.Annotations)
+				if test.expectedBackupVSClass != "" {
+					assert.Equal(t, test.expectedBackupVSClass, *expectedVS.Spec.VolumeSnapshotClassName)
+					assert.Equal(t, test.expectedBackupVSClass, *expectedVSC.Spec.VolumeSnapshotClassName)
+				} else {
+					assert.Equal(t, *expectedVS.Spec.VolumeSnapshotClassName, *vsObject.Spec.VolumeSnapshotClassName)
+					assert.Equal(t, *expectedVSC.Spec.VolumeSnapshotClassName, *vscObj.Spec.VolumeSnapshotClassName)
+				}
+
+				// the owner UID label is added to the backup VS and VSC once the owner has UID
+				expectedBackupSnapshotLabels := test.expectedBackupSnapshotLabels
+				if ownerObject.UID != "" {
+					expectedBackupSnapshotLabels = map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)}
+					for k, v := range test.expectedBackupSnapshotLabels {
+						expectedBackupSnapshotLabels[k] = v
+					}
+				}
+
+				assert.Equal(t, expectedVSC.Name, *expectedVS.Spec.Source.VolumeSnapshotContentName)
+				assert.Equal(t, expectedBackupSnapshotLabels, expectedVS.Labels)
+
+				if test.expectedBackupVSCPolicy != "" {
+					assert.Equal(t, test.expectedBackupVSCPolicy, expectedVSC.Spec.DeletionPolicy)
+				}
+
+				if test.expectedSourceSnapshotKept {
+					_, err = exposer.csiSnapshotClient.VolumeSnapshots(vsObject.Namespace).Get(context.Background(), vsObject.Name, metav1.GetOptions{})
+					require.NoError(t, err)
+
+					sourceVSC, err := exposer.csiSnapshotClient.VolumeSnapshotContents().Get(context.Background(), vscObj.Name, metav1.GetOptions{})
+					require.NoError(t, err)
+					assert.Equal(t, snapshotv1api.VolumeSnapshotContentDelete, sourceVSC.Spec.DeletionPolicy)
+				}
+				assert.Equal(t, expectedBackupSnapshotLabels, expectedVSC.Labels)
+
+				assert.Equal(t, expectedVSC.Annotations, vscObj.Annotations)
+				if test.expectedBackupVSCPolicy == "" {
+					assert.Equal(t, expectedVSC.Spec.DeletionPolicy, vscObj.Spec.DeletionPolicy)
+				}
+				assert.Equal(t, expectedVSC.Spec.Driver, vscObj.Spec.Driver)
+
+				if test.expectedVolumeSize != nil {
+					assert.Equal(t, *test.expectedVolumeSize, backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage])
+				} else {
+					assert.Equal(t, *resource.NewQuantity(restoreSize, ""), backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage])
+				}
+
+				if test.expectedReadOnlyPVC {
+					gotReadOnlyAccessMode := false
+					for _, accessMode := range backupPVC.Spec.AccessModes {
+						if accessMode == corev1api.ReadOnlyMany {
+							gotReadOnlyAccessMode = true
+						}
+					}
+					assert.Equal(t, test.expectedReadOnlyPVC, gotReadOnlyAccessMode)
+
+					for _, volume := range backupPod.Spec.Volumes {
+						if volume.Name == string(ownerObject.UID) {
+							assert.True(t, volume.PersistentVolumeClaim.ReadOnly)
+						}
+					}
+
+					for _, mount := range backupPod.Spec.Containers[0].VolumeMounts {
+						if mount.Name == string(ownerObject.UID) {
+							assert.True(t, mount.ReadOnly)
+						}
+					}
+				}
+
+				if test.expectedVolumeDevices != nil {
+					assert.Equal(t, test.expectedVolumeDevices, backupPod.Spec.Containers[0].VolumeDevices)
+					assert.Empty(t, backupPod.Spec.Containers[0].VolumeMounts)
+				}
+
+				if test.expectedBackupPVCStorageClass != "" {
+					assert.Equal(t, test.expectedBackupPVCStorageClass, *backupPVC.Spec.StorageClassName)
+				}
+
+				if test.expectedAffinity != nil {
+					assert.Equal(t, test.expectedAffinity, backupPod.Spec.Affinity)
+				}
+
+				if test.expectedPodAnnotations != nil {
+					assert.Equal(t, test.expectedPodAnnotations, backupPod.Annotations)
+				}
+
+				if test.expectedSecurityContext != nil {
+					assert.Equal(t, test.expectedSecurityContext, backupPod.Spec.SecurityContext)
+				}
+
+				if test.expectedNodeSelector != nil {
+					assert.Equal(t, test.expectedNodeSelector, backupPod.Spec.NodeSelector)
+				}
+
+				assert.Equal(t, test.expectedActiveDeadlineSeconds, backupPod.Spec.ActiveDeadlineSeconds)
+				assert.Equal(t, test.expectedEnv, backupPod.Spec.Containers[0].Env)
+
+				if test.expectedResources != nil {
+					assert.Equal(t, *test.expectedResources, backupPod.Spec.Containers[0].Resources)
+				}
+
+				if test.expectedTopologySpread != nil {
+					assert.Equal(t, test.expectedTopologySpread, backupPod.Spec.TopologySpreadConstraints)
+				}
+
+				if test.expectedVolumes != nil {
+					assert.Equal(t, test.expectedVolumes, backupPod.Spec.Volumes)
+					assert.Equal(t, test.expectedVolumeMounts, backupPod.Spec.Containers[0].VolumeMounts)
+					assert.Equal(t, test.expectedVolumes[0].Name, backupPod.Spec.Containers[0].Name)
+				}
+
+				expectedGracePeriod := int64(0)
+				if test.expectedGracePeriod != nil {
+					expectedGracePeriod = *test.expectedGracePeriod
+				}
+				require.NotNil(t, backupPod.Spec.TerminationGracePeriodSeconds)
+				assert.Equal(t, expectedGracePeriod, *backupPod.Spec.TerminationGracePeriodSeconds)
+				assert.Equal(t, test.expectedRuntimeClass, backupPod.Spec.RuntimeClassName)
+				assert.Equal(t, test.expectedVolumeAttributesClass, backupPVC.Spec.VolumeAttributesClassName)
+
+				if test.expectedRestartPolicy != "" {
+					assert.Equal(t, test.expectedRestartPolicy, backupPod.Spec.RestartPolicy)
+				}
+
+				if test.expectedDNSPolicy != "" {
+					assert.Equal(t, test.expectedDNSPolicy, backupPod.Spec.DNSPolicy)
+					assert.Equal(t, test.expectedDNSConfig, backupPod.Spec.DNSConfig)
+				}
+
+				assert.Equal(t, test.expectedBackupPVCSelector, backupPVC.Spec.Selector)
+				assert.Equal(t, test.expectedBackupPVCAnnotations, backupPVC.Annotations)
+
+				if test.expectedStaticBackupPV != "" {
+					backupPV, err := exposer.kubeClient.CoreV1().PersistentVolumes().Get(context.Background(), test.expectedStaticBackupPV, metav1.GetOptions{})
+					require.NoError(t, err)
+
+					assert.Equal(t, "fake-driver", backupPV.Spec.CSI.Driver)
+					assert.Equal(t, snapshotHandle, backupPV.Spec.CSI.VolumeHandle)
+					assert.Equal(t, corev1api.PersistentVolumeReclaimRetain, backupPV.Spec.PersistentVolumeReclaimPolicy)
+					assert.Equal(t, backupPVC.Name, backupPV.Spec.ClaimRef.Name)
+					assert.Equal(t, backupPVC.Namespace, backupPV.Spec.ClaimRef.Namespace)
+					assert.Equal(t, backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage], backupPV.Spec.Capacity[corev1api.ResourceStorage])
+					assert.Equal(t, string(ownerObject.UID), backupPV.Annotations[staticBackupPVAnnotation])
+
+					assert.Equal(t, test.expectedStaticBackupPV, backupPVC.Spec.VolumeName)
+					assert.Nil(t, backupPVC.Spec.DataSource)
+
+					if test.expectedBackupPVCSelector != nil {
+						assert.Equal(t, test.expectedBackupPVCSelector.MatchLabels, backupPV.Labels)
+					}
+				} else {
+					assert.Empty(t, backupPVC.Spec.VolumeName)
+					assert.NotNil(t, backupPVC.Spec.DataSource)
+				}
+			} else {
+				assert.EqualError(t, err, test.err)
+
+				for _, kind := range test.expectedErrKinds {
+					assert.ErrorIs(t, err, kind)
+				}
+			}
+		})
+	}
+}
+
+func TestGetExpose(t *testing.T) {
+	backup := &velerov1.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1.SchemeGroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			UID:       "fake-uid",
+		},
+	}
+
+	backupPodScheduling := &ExposeScheduling{
+		NodeSelector: map[string]string{
+			kube.NodeOSLabel: kube.NodeOSWindows,
+		},
+		Tolerations: []corev1api.Toleration{
+			{
+				Key:      "os",
+				Operator: "Equal",
+				Effect:   "NoSchedule",
+				Value:    "windows",
+			},
+		},
+		Affinity: kube.ToSystemAffinity([]*kube.LoadAffinity{
+			{
+				NodeSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/hostname": "node-1"},
+				},
+			},
+		}),
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+		Spec: corev1api.PodSpec{
+			Volumes: []corev1api.Volume{
+				{
+					Name: "fake-volume",
+				},
+				{
+					Name: "fake-volume-2",
+				},
+				{
+					Name: string(backup.UID),
+				},
+			},
+			NodeSelector: backupPodScheduling.NodeSelector,
+			Tolerations:  backupPodScheduling.Tolerations,
+			Affinity:     backupPodScheduling.Affinity,
+		},
+	}
+
+	backupPodWithoutVolume := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+		Spec: corev1api.PodSpec{
+			Volumes: []corev1api.Volume{
+				{
+					Name: "fake-volume-1",
+				},
+				{
+					Name: "fake-volume-2",
+				},
+			},
+		},
+	}
+
+	backupPodWithBundledVolumes := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+		Spec: corev1api.PodSpec{
+			Volumes: []corev1api.Volume{
+				{
+					Name: string(backup.UID),
+				},
+				{
+					Name: "fake-volume",
+				},
+				{
+					Name: string(backup.UID) + "-1",
+				},
+				{
+					Name: string(backup.UID) + "-2",
+				},
+			},
+			Containers: []corev1api.Container{
+				{
+					Name: string(backup.UID),
+					VolumeMounts: []corev1api.VolumeMount{
+						{Name: string(backup.UID)},
+						{Name: string(backup.UID) + "-1"},
+					},
+				},
+				{
+					Name: "fake-mover-2",
+					VolumeDevices: []corev1api.VolumeDevice{
+						{Name: string(backup.UID) + "-2"},
+					},
+				},
+			},
+			NodeSelector: backupPodScheduling.NodeSelector,
+			Tolerations:  backupPodScheduling.Tolerations,
+			Affinity:     backupPodScheduling.Affinity,
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv-name",
+		},
+	}
+
+	backupPV := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv-name",
+		},
+	}
+
+	backupPVRetain := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv-name",
+		},
+		Spec: corev1api.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: corev1api.PersistentVolumeReclaimRetain,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	corev1api.AddToScheme(scheme)
+
+	tests := []struct {
+		name                          string
+		kubeClientObj                 []runtime.Object
+		ownerBackup                   *velerov1.Backup
+		exposeWaitParam               CSISnapshotExposeWaitParam
+		Timeout                       time.Duration
+		err                           string
+		expectedResult                *ExposeResult
+		expectedReclaimPolicy         corev1api.PersistentVolumeReclaimPolicy
+		expectedOriginalReclaimPolicy string
+	}{
+		{
+			name:        "backup pod is not found",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
+				NodeName: "fake-node",
+			},
+		},
+		{
+			name:        "wait pvc bound fail",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
+				NodeName: "fake-node",
+			},
+			kubeClientObj: []runtime.Object{
+				backupPod,
+			},
+			Timeout: time.Second,
+			err:     "error to wait backup PVC bound, fake-backup: error to wait for rediness of PVC: error to get pvc velero/fake-backup: persistentvolumeclaims \"fake-backup\" not found",
+		},
+		{
+			name:        "backup volume not found in pod",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
+				NodeName: "fake-node",
+			},
+			kubeClientObj: []runtime.Object{
+				backupPodWithoutVolume,
+				backupPVC,
+				backupPV,
+			},
+			Timeout: time.Second,
+			err:     "backup pod fake-backup doesn't have the expected backup volume",
+		},
+		{
+			name:        "succeed",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
+				NodeName: "fake-node",
+			},
+			kubeClientObj: []runtime.Object{
+				backupPod,
+				backupPVC,
+				backupPV,
+			},
+			Timeout: time.Second,
+			expectedResult: &ExposeResult{
+				ByPod: ExposeByPod{
+					HostingPod: backupPod,
+					VolumeName: string(backup.UID),
+				},
+			},
+		},
+		{
+			name:        "succeed with name source",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
+				NodeName:   "fake-node",
+				NameSource: "fake-volume-2",
+			},
+			kubeClientObj: []runtime.Object{
+				backupPod,
+				backupPVC,
+				backupPV,
+			},
+			Timeout: time.Second,
+			expectedResult: &ExposeResult{
+				ByPod: ExposeByPod{
+					HostingPod: backupPod,
+					VolumeName: "fake-volume-2",
+				},
+			},
+		},
+		{
+			name:        "succeed with bundled volumes",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
+				NodeName: "fake-node",
+			},
+			kubeClientObj: []runtime.Object{
+				backupPodWithBundledVolumes,
+				backupPVC,
+				backupPV,
+			},
+			Timeout: time.Second,
+			expectedResult: &ExposeResult{
+				ByPod: ExposeByPod{
+					HostingPod: backupPodWithBundledVolumes,
+					VolumeName: string(backup.UID),
+					Volumes: []ExposeVolume{
+						{VolumeName: string(backup.UID), ContainerName: string(backup.UID)},
+						{VolumeName: string(backup.UID) + "-1", ContainerName: string(backup.UID)},
+						{VolumeName: string(backup.UID) + "-2", ContainerName: "fake-mover-2"},
+					},
+				},
+			},
+		},
+		{
+			name:        "succeed, reclaim policy is not changed by default",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
 				NodeName: "fake-node",
 			},
 			kubeClientObj: []runtime.Object{
-				backupPod,
-				backupPVC,
-				backupPV,
+				backupPod,
+				backupPVC,
+				backupPVRetain,
+			},
+			Timeout: time.Second,
+			expectedResult: &ExposeResult{
+				ByPod: ExposeByPod{
+					HostingPod: backupPod,
+					VolumeName: string(backup.UID),
+				},
+			},
+			expectedReclaimPolicy: corev1api.PersistentVolumeReclaimRetain,
+		},
+		{
+			name:        "succeed, reclaim policy is forced to delete",
+			ownerBackup: backup,
+			exposeWaitParam: CSISnapshotExposeWaitParam{
+				NodeName:             "fake-node",
+				ForcePVReclaimDelete: true,
+			},
+			kubeClientObj: []runtime.Object{
+				backupPod,
+				backupPVC,
+				backupPVRetain,
+			},
+			Timeout: time.Second,
+			expectedResult: &ExposeResult{
+				ByPod: ExposeByPod{
+					HostingPod: backupPod,
+					VolumeName: string(backup.UID),
+				},
+			},
+			expectedReclaimPolicy:         corev1api.PersistentVolumeReclaimDelete,
+			expectedOriginalReclaimPolicy: string(corev1api.PersistentVolumeReclaimRetain),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+
+			fakeClientBuilder := clientFake.NewClientBuilder()
+			fakeClientBuilder = fakeClientBuilder.WithScheme(scheme)
+
+			fakeClient := fakeClientBuilder.WithRuntimeObjects(test.kubeClientObj...).Build()
+
+			exposer := csiSnapshotExposer{
+				kubeClient: fakeKubeClient,
+				log:        velerotest.NewLogger(),
+			}
+
+			var ownerObject corev1api.ObjectReference
+			if test.ownerBackup != nil {
+				ownerObject = corev1api.ObjectReference{
+					Kind:       test.ownerBackup.Kind,
+					Namespace:  test.ownerBackup.Namespace,
+					Name:       test.ownerBackup.Name,
+					UID:        test.ownerBackup.UID,
+					APIVersion: test.ownerBackup.APIVersion,
+				}
+			}
+
+			test.exposeWaitParam.NodeClient = fakeClient
+
+			result, err := exposer.GetExposed(context.Background(), ownerObject, test.Timeout, &test.exposeWaitParam)
+			if test.err == "" {
+				require.NoError(t, err)
+
+				if test.expectedResult == nil {
+					assert.Nil(t, result)
+				} else {
+					require.NoError(t, err)
+					assert.Equal(t, test.expectedResult.ByPod.VolumeName, result.ByPod.VolumeName)
+					assert.Equal(t, test.expectedResult.ByPod.HostingPod.Name, result.ByPod.HostingPod.Name)
+					assert.Equal(t, backupPodScheduling, result.ByPod.Scheduling)
+
+					expectedVolumes := test.expectedResult.ByPod.Volumes
+					if expectedVolumes == nil {
+						expectedVolumes = []ExposeVolume{{VolumeName: test.expectedResult.ByPod.VolumeName, ContainerName: test.expectedResult.ByPod.VolumeName}}
+					}
+					assert.Equal(t, expectedVolumes, result.ByPod.Volumes)
+					assert.Equal(t, expectedVolumes[0].ContainerName, result.ByPod.HostingContainer)
+				}
+
+				if test.expectedReclaimPolicy != "" {
+					pv, err := fakeKubeClient.CoreV1().PersistentVolumes().Get(context.Background(), "fake-pv-name", metav1.GetOptions{})
+					require.NoError(t, err)
+
+					assert.Equal(t, test.expectedReclaimPolicy, pv.Spec.PersistentVolumeReclaimPolicy)
+					assert.Equal(t, test.expectedOriginalReclaimPolicy, pv.Annotations[originalReclaimPolicyAnnotation])
+				}
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestPeekExpose(t *testing.T) {
+	backup := &velerov1.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1.SchemeGroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			UID:       "fake-uid",
+		},
+	}
+
+	backupPodUrecoverable := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodFailed,
+		},
+	}
+
+	backupPodDeadlineExceeded := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+		Status: corev1api.PodStatus{
+			Phase:   corev1api.PodFailed,
+			Reason:  "DeadlineExceeded",
+			Message: "Pod was active on the node longer than the specified deadline",
+		},
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+	}
+
+	backupPodWithNode := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: "fake-node",
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodRunning,
+		},
+	}
+
+	backupPodRestarted := func(restarts int32) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   backup.Namespace,
+				Name:        backup.Name,
+				Annotations: map[string]string{podRestartLimitAnnotation: "3"},
+			},
+			Status: corev1api.PodStatus{
+				Phase: corev1api.PodRunning,
+				ContainerStatuses: []corev1api.ContainerStatus{
+					{
+						Name:         string(backup.UID),
+						RestartCount: restarts,
+						LastTerminationState: corev1api.ContainerState{
+							Terminated: &corev1api.ContainerStateTerminated{
+								Message: "fake-mount-error",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	backupPodRestartedWithoutLimit := backupPodRestarted(10)
+	backupPodRestartedWithoutLimit.Annotations = nil
+
+	nodeNotReadySince := func(since time.Duration) *corev1api.Node {
+		return &corev1api.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-node",
+			},
+			Status: corev1api.NodeStatus{
+				Conditions: []corev1api.NodeCondition{
+					{
+						Type:               corev1api.NodeReady,
+						Status:             corev1api.ConditionUnknown,
+						LastTransitionTime: metav1.NewTime(time.Now().Add(-since)),
+					},
+				},
+			},
+		}
+	}
+
+	nodeReady := &corev1api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-node",
+		},
+		Status: corev1api.NodeStatus{
+			Conditions: []corev1api.NodeCondition{
+				{
+					Type:               corev1api.NodeReady,
+					Status:             corev1api.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	corev1api.AddToScheme(scheme)
+
+	tests := []struct {
+		name               string
+		kubeClientObj      []runtime.Object
+		ownerBackup        *velerov1.Backup
+		nodeNotReadyGrace  time.Duration
+		err                string
+		expectedErrKinds   []error
+		expectedPeekReason PeekReason
+	}{
+		{
+			name:        "backup pod is not found",
+			ownerBackup: backup,
+		},
+		{
+			name:        "pod is unrecoverable",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodUrecoverable,
+			},
+			err:                "Pod is in abnormal state [Failed], message []",
+			expectedPeekReason: PeekReasonContainerError,
+		},
+		{
+			name:        "pod exceeded active deadline",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodDeadlineExceeded,
+			},
+			err:                "Pod exceeded its active deadline, message [Pod was active on the node longer than the specified deadline]",
+			expectedPeekReason: PeekReasonContainerError,
+		},
+		{
+			name:        "succeed",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPod,
+			},
+		},
+		{
+			name:        "pod restarts within limit",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodRestarted(3),
+			},
+		},
+		{
+			name:        "pod restarts exceed limit",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodRestarted(4),
+			},
+			err:                "Container fake-uid in Pod velero/fake-backup has restarted 4 times, exceeding the limit 3, message [fake-mount-error]",
+			expectedPeekReason: PeekReasonContainerError,
+		},
+		{
+			name:        "pod restarts without limit",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodRestartedWithoutLimit,
+			},
+		},
+		{
+			name:        "node not ready, grace not set",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodWithNode,
+				nodeNotReadySince(time.Hour),
+			},
+		},
+		{
+			name:        "node not ready, within grace",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodWithNode,
+				nodeNotReadySince(time.Minute),
+			},
+			nodeNotReadyGrace: 5 * time.Minute,
+		},
+		{
+			name:        "node not ready, after grace",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodWithNode,
+				nodeNotReadySince(10 * time.Minute),
+			},
+			nodeNotReadyGrace: 5 * time.Minute,
+			err:               "node fake-node of backup pod fake-backup is not ready since",
+			expectedErrKinds:  []error{ErrNodeNotReady},
+		},
+		{
+			name:        "node ready",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodWithNode,
+				nodeReady,
+			},
+			nodeNotReadyGrace: 5 * time.Minute,
+		},
+		{
+			name:        "node not found",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				backupPodWithNode,
+			},
+			nodeNotReadyGrace: 5 * time.Minute,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+
+			exposer := csiSnapshotExposer{
+				kubeClient:        fakeKubeClient,
+				log:               velerotest.NewLogger(),
+				nodeNotReadyGrace: test.nodeNotReadyGrace,
+			}
+
+			var ownerObject corev1api.ObjectReference
+			if test.ownerBackup != nil {
+				ownerObject = corev1api.ObjectReference{
+					Kind:       test.ownerBackup.Kind,
+					Namespace:  test.ownerBackup.Namespace,
+					Name:       test.ownerBackup.Name,
+					UID:        test.ownerBackup.UID,
+					APIVersion: test.ownerBackup.APIVersion,
+				}
+			}
+
+			err := exposer.PeekExposed(context.Background(), ownerObject)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else if test.expectedErrKinds != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+
+			for _, kind := range test.expectedErrKinds {
+				assert.ErrorIs(t, err, kind)
+			}
+
+			if test.expectedPeekReason != "" {
+				var peekErr *PeekError
+				require.ErrorAs(t, err, &peekErr)
+				assert.Equal(t, test.expectedPeekReason, peekErr.Reason)
+				assert.Equal(t, test.err, peekErr.Message)
+			}
+		})
+	}
+}
+
+func Test_csiSnapshotExposer_createBackupPVC(t *testing.T) {
+	backup := &velerov1.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1.SchemeGroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			UID:       "fake-uid",
+		},
+	}
+
+	dataSource := &corev1api.TypedLocalObjectReference{
+		APIGroup: &snapshotv1api.SchemeGroupVersion.Group,
+		Kind:     "VolumeSnapshot",
+		Name:     "fake-snapshot",
+	}
+	volumeMode := corev1api.PersistentVolumeFilesystem
+
+	backupPVC := corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+					Controller: pointer.BoolPtr(true),
+				},
+			},
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			AccessModes: []corev1api.PersistentVolumeAccessMode{
+				corev1api.ReadWriteOnce,
+			},
+			VolumeMode:       &volumeMode,
+			DataSource:       dataSource,
+			DataSourceRef:    nil,
+			StorageClassName: pointer.String("fake-storage-class"),
+			Resources: corev1api.VolumeResourceRequirements{
+				Requests: corev1api.ResourceList{
+					corev1api.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+
+	backupPVCReadOnly := corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+					Controller: pointer.BoolPtr(true),
+				},
+			},
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			AccessModes: []corev1api.PersistentVolumeAccessMode{
+				corev1api.ReadOnlyMany,
+			},
+			VolumeMode:       &volumeMode,
+			DataSource:       dataSource,
+			DataSourceRef:    nil,
+			StorageClassName: pointer.String("fake-storage-class"),
+			Resources: corev1api.VolumeResourceRequirements{
+				Requests: corev1api.ResourceList{
+					corev1api.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		ownerBackup       *velerov1.Backup
+		backupVS          string
+		storageClass      string
+		accessMode        string
+		resource          resource.Quantity
+		readOnly          bool
+		kubeClientObj     []runtime.Object
+		snapshotClientObj []runtime.Object
+		want              *corev1api.PersistentVolumeClaim
+		wantErr           assert.ErrorAssertionFunc
+	}{
+		{
+			name:         "backupPVC gets created successfully with parameters from source PVC",
+			ownerBackup:  backup,
+			backupVS:     "fake-snapshot",
+			storageClass: "fake-storage-class",
+			accessMode:   AccessModeFileSystem,
+			resource:     resource.MustParse("1Gi"),
+			readOnly:     false,
+			want:         &backupPVC,
+			wantErr:      assert.NoError,
+		},
+		{
+			name:         "backupPVC gets created successfully with parameters from source PVC but accessMode from backupPVC Config as read only",
+			ownerBackup:  backup,
+			backupVS:     "fake-snapshot",
+			storageClass: "fake-storage-class",
+			accessMode:   AccessModeFileSystem,
+			resource:     resource.MustParse("1Gi"),
+			readOnly:     true,
+			want:         &backupPVCReadOnly,
+			wantErr:      assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(tt.kubeClientObj...)
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(tt.snapshotClientObj...)
+			e := &csiSnapshotExposer{
+				kubeClient:        fakeKubeClient,
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+			var ownerObject corev1api.ObjectReference
+			if tt.ownerBackup != nil {
+				ownerObject = corev1api.ObjectReference{
+					Kind:       tt.ownerBackup.Kind,
+					Namespace:  tt.ownerBackup.Namespace,
+					Name:       tt.ownerBackup.Name,
+					UID:        tt.ownerBackup.UID,
+					APIVersion: tt.ownerBackup.APIVersion,
+				}
+			}
+			got, _, err := e.createBackupPVC(context.Background(), ownerObject, ownerObject.Namespace, tt.backupVS, tt.storageClass, tt.accessMode, tt.resource, tt.readOnly, getPVCCreateBackoff(&CSISnapshotExposeParam{}), "", nil, nil, nil)
+			if !tt.wantErr(t, err, fmt.Sprintf("createBackupPVC(%v, %v, %v, %v, %v, %v)", ownerObject, tt.backupVS, tt.storageClass, tt.accessMode, tt.resource, tt.readOnly)) {
+				return
+			}
+			assert.Equalf(t, tt.want, got, "createBackupPVC(%v, %v, %v, %v, %v, %v)", ownerObject, tt.backupVS, tt.storageClass, tt.accessMode, tt.resource, tt.readOnly)
+		})
+	}
+}
+
+func TestCreateBackupPVCRetry(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+	}
+
+	pvcResource := schema.GroupResource{Resource: "persistentvolumeclaims"}
+
+	tests := []struct {
+		name             string
+		maxAttempts      int
+		createErrs       []error
+		createdOnError   bool
+		expectedAttempts int
+		expectedErr      string
+	}{
+		{
+			name:             "single attempt by default",
+			createErrs:       []error{apierrors.NewTooManyRequests("fake-throttle", 1)},
+			expectedAttempts: 1,
+			expectedErr:      "error to create pvc: fake-throttle",
+		},
+		{
+			name:             "succeed after transient errors",
+			maxAttempts:      5,
+			createErrs:       []error{apierrors.NewTooManyRequests("fake-throttle", 1), apierrors.NewServerTimeout(pvcResource, "create", 1), apierrors.NewConflict(pvcResource, "fake-backup", errors.New("fake-conflict"))},
+			expectedAttempts: 4,
+		},
+		{
+			name:             "permanent error fails immediately",
+			maxAttempts:      5,
+			createErrs:       []error{apierrors.NewInvalid(schema.GroupKind{Kind: "PersistentVolumeClaim"}, "fake-backup", nil)},
+			expectedAttempts: 1,
+			expectedErr:      "error to create pvc: PersistentVolumeClaim \"fake-backup\" is invalid",
+		},
+		{
+			name:             "attempts exhausted",
+			maxAttempts:      3,
+			createErrs:       []error{apierrors.NewTooManyRequests("fake-throttle", 1), apierrors.NewTooManyRequests("fake-throttle", 1), apierrors.NewTooManyRequests("fake-throttle", 1)},
+			expectedAttempts: 3,
+			expectedErr:      "error to create pvc: fake-throttle",
+		},
+		{
+			name:             "created by a timed out attempt",
+			maxAttempts:      3,
+			createErrs:       []error{apierrors.NewServerTimeout(pvcResource, "create", 1)},
+			createdOnError:   true,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset()
+
+			attempts := 0
+			fakeKubeClient.PrependReactor("create", "persistentvolumeclaims", func(action clientTesting.Action) (bool, runtime.Object, error) {
+				attempts++
+				if attempts > len(tt.createErrs) {
+					return false, nil, nil
+				}
+
+				if tt.createdOnError {
+					obj := action.(clientTesting.CreateAction).GetObject()
+					require.NoError(t, fakeKubeClient.Tracker().Add(obj))
+				}
+
+				return true, nil, tt.createErrs[attempts-1]
+			})
+
+			e := &csiSnapshotExposer{
+				kubeClient: fakeKubeClient,
+				log:        velerotest.NewLogger(),
+			}
+
+			backoff := getPVCCreateBackoff(&CSISnapshotExposeParam{
+				PVCCreateMaxAttempts:    tt.maxAttempts,
+				PVCCreateRetryBaseDelay: time.Millisecond,
+			})
+
+			pvc, _, err := e.createBackupPVC(context.Background(), ownerObject, ownerObject.Namespace, "fake-vs", "fake-sc", AccessModeFileSystem, resource.MustParse("1Gi"), false, backoff, "", nil, nil, nil)
+			assert.Equal(t, tt.expectedAttempts, attempts)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, ownerObject.Name, pvc.Name)
+		})
+	}
+}
+
+func Test_csiSnapshotExposer_DiagnoseExpose(t *testing.T) {
+	backup := &velerov1.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1.SchemeGroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			UID:       "fake-uid",
+		},
+	}
+
+	backupPodWithoutNodeName := corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodPending,
+			Conditions: []corev1api.PodCondition{
+				{
+					Type:    corev1api.PodInitialized,
+					Status:  corev1api.ConditionTrue,
+					Message: "fake-pod-message",
+				},
+			},
+		},
+	}
+
+	backupPodWithNodeName := corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: "fake-node",
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodPending,
+			Conditions: []corev1api.PodCondition{
+				{
+					Type:    corev1api.PodInitialized,
+					Status:  corev1api.ConditionTrue,
+					Message: "fake-pod-message",
+				},
+			},
+		},
+	}
+
+	backupPVCWithoutVolumeName := corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+		Status: corev1api.PersistentVolumeClaimStatus{
+			Phase: corev1api.ClaimPending,
+		},
+	}
+
+	backupPVCWithVolumeName := corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv",
+		},
+		Status: corev1api.PersistentVolumeClaimStatus{
+			Phase: corev1api.ClaimPending,
+		},
+	}
+
+	backupPV := corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv",
+		},
+		Status: corev1api.PersistentVolumeStatus{
+			Phase:   corev1api.VolumePending,
+			Message: "fake-pv-message",
+		},
+	}
+
+	readyToUse := false
+	vscMessage := "fake-vsc-message"
+	backupVSC := snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-vsc",
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			ReadyToUse: &readyToUse,
+			Error: &snapshotv1api.VolumeSnapshotError{
+				Message: &vscMessage,
+			},
+		},
+	}
+
+	backupVSWithoutStatus := snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+	}
+
+	backupVSWithoutVSC := snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{},
+	}
+
+	vsMessage := "fake-vs-message"
+	backupVSWithVSC := snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: backup.APIVersion,
+					Kind:       backup.Kind,
+					Name:       backup.Name,
+					UID:        backup.UID,
+				},
+			},
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &backupVSC.Name,
+			Error: &snapshotv1api.VolumeSnapshotError{
+				Message: &vsMessage,
+			},
+		},
+	}
+
+	nodeAgentPod := corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "node-agent-pod-1",
+			Labels:    map[string]string{"role": "node-agent"},
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: "fake-node",
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodRunning,
+		},
+	}
+
+	tests := []struct {
+		name              string
+		ownerBackup       *velerov1.Backup
+		kubeClientObj     []runtime.Object
+		snapshotClientObj []runtime.Object
+		expected          string
+	}{
+		{
+			name:        "no pod, pvc, vs",
+			ownerBackup: backup,
+			expected: `begin diagnose CSI exposer
+error getting backup pod fake-backup, err: pods "fake-backup" not found
+error getting backup pvc fake-backup, err: persistentvolumeclaims "fake-backup" not found
+error getting backup vs fake-backup, err: volumesnapshots.snapshot.storage.k8s.io "fake-backup" not found
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "pod without node name, pvc without volume name, vs without status",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithoutNodeName,
+				&backupPVCWithoutVolumeName,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithoutStatus,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name 
+Pod condition Initialized, status True, reason , message fake-pod-message
+PVC velero/fake-backup, phase Pending, binding to 
+VS velero/fake-backup, bind to , readyToUse false, errMessage 
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "pod without node name, pvc without volume name, vs without VSC",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithoutNodeName,
+				&backupPVCWithoutVolumeName,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithoutVSC,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name 
+Pod condition Initialized, status True, reason , message fake-pod-message
+PVC velero/fake-backup, phase Pending, binding to 
+VS velero/fake-backup, bind to , readyToUse false, errMessage 
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "pod with node name, no node agent",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithNodeName,
+				&backupPVCWithoutVolumeName,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithoutVSC,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name fake-node
+Pod condition Initialized, status True, reason , message fake-pod-message
+node-agent is not running in node fake-node, err: daemonset pod not found in running state in node fake-node
+PVC velero/fake-backup, phase Pending, binding to 
+VS velero/fake-backup, bind to , readyToUse false, errMessage 
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "pod with node name, node agent is running",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithNodeName,
+				&backupPVCWithoutVolumeName,
+				&nodeAgentPod,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithoutVSC,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name fake-node
+Pod condition Initialized, status True, reason , message fake-pod-message
+PVC velero/fake-backup, phase Pending, binding to 
+VS velero/fake-backup, bind to , readyToUse false, errMessage 
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "pvc with volume name, no pv",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithNodeName,
+				&backupPVCWithVolumeName,
+				&nodeAgentPod,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithoutVSC,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name fake-node
+Pod condition Initialized, status True, reason , message fake-pod-message
+PVC velero/fake-backup, phase Pending, binding to fake-pv
+error getting backup pv fake-pv, err: persistentvolumes "fake-pv" not found
+VS velero/fake-backup, bind to , readyToUse false, errMessage 
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "pvc with volume name, pv exists",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithNodeName,
+				&backupPVCWithVolumeName,
+				&backupPV,
+				&nodeAgentPod,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithoutVSC,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name fake-node
+Pod condition Initialized, status True, reason , message fake-pod-message
+PVC velero/fake-backup, phase Pending, binding to fake-pv
+PV fake-pv, phase Pending, reason , message fake-pv-message
+VS velero/fake-backup, bind to , readyToUse false, errMessage 
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "vs with vsc, vsc doesn't exist",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithNodeName,
+				&backupPVCWithVolumeName,
+				&backupPV,
+				&nodeAgentPod,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithVSC,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name fake-node
+Pod condition Initialized, status True, reason , message fake-pod-message
+PVC velero/fake-backup, phase Pending, binding to fake-pv
+PV fake-pv, phase Pending, reason , message fake-pv-message
+VS velero/fake-backup, bind to fake-vsc, readyToUse false, errMessage fake-vs-message
+error getting backup vsc fake-vsc, err: volumesnapshotcontents.snapshot.storage.k8s.io "fake-vsc" not found
+end diagnose CSI exposer`,
+		},
+		{
+			name:        "vs with vsc, vsc exists",
+			ownerBackup: backup,
+			kubeClientObj: []runtime.Object{
+				&backupPodWithNodeName,
+				&backupPVCWithVolumeName,
+				&backupPV,
+				&nodeAgentPod,
+			},
+			snapshotClientObj: []runtime.Object{
+				&backupVSWithVSC,
+				&backupVSC,
+			},
+			expected: `begin diagnose CSI exposer
+Pod velero/fake-backup, phase Pending, node name fake-node
+Pod condition Initialized, status True, reason , message fake-pod-message
+PVC velero/fake-backup, phase Pending, binding to fake-pv
+PV fake-pv, phase Pending, reason , message fake-pv-message
+VS velero/fake-backup, bind to fake-vsc, readyToUse false, errMessage fake-vs-message
+VSC fake-vsc, readyToUse false, errMessage fake-vsc-message, handle 
+end diagnose CSI exposer`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(tt.kubeClientObj...)
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(tt.snapshotClientObj...)
+			e := &csiSnapshotExposer{
+				kubeClient:        fakeKubeClient,
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+			var ownerObject corev1api.ObjectReference
+			if tt.ownerBackup != nil {
+				ownerObject = corev1api.ObjectReference{
+					Kind:       tt.ownerBackup.Kind,
+					Namespace:  tt.ownerBackup.Namespace,
+					Name:       tt.ownerBackup.Name,
+					UID:        tt.ownerBackup.UID,
+					APIVersion: tt.ownerBackup.APIVersion,
+				}
+			}
+
+			diag := e.DiagnoseExpose(context.Background(), ownerObject)
+			assert.Equal(t, tt.expected, diag)
+		})
+	}
+}
+
+func TestDiagnoseExposeStructured(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: "fake-node",
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodPending,
+			Conditions: []corev1api.PodCondition{
+				{
+					Type:    corev1api.PodScheduled,
+					Status:  corev1api.ConditionTrue,
+					Message: "fake-pod-message",
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv",
+		},
+		Status: corev1api.PersistentVolumeClaimStatus{
+			Phase: corev1api.ClaimBound,
+		},
+	}
+
+	backupPV := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv",
+		},
+		Status: corev1api.PersistentVolumeStatus{
+			Phase: corev1api.VolumeBound,
+		},
+	}
+
+	nodeAgentPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "node-agent-pod-1",
+			Labels:    map[string]string{"role": "node-agent"},
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: "fake-node",
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodRunning,
+		},
+	}
+
+	readyToUse := true
+	vscName := "fake-vsc"
+	snapshotHandle := "fake-handle"
+	backupVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     &readyToUse,
+		},
+	}
+
+	backupVSC := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			ReadyToUse:     &readyToUse,
+			SnapshotHandle: &snapshotHandle,
+		},
+	}
+
+	tests := []struct {
+		name              string
+		kubeClientObj     []runtime.Object
+		snapshotClientObj []runtime.Object
+		expected          *ExposeDiagnosis
+	}{
+		{
+			name: "no objects",
+			expected: &ExposeDiagnosis{
+				PodError: `error getting backup pod fake-backup, err: pods "fake-backup" not found`,
+				PVCError: `error getting backup pvc fake-backup, err: persistentvolumeclaims "fake-backup" not found`,
+				VSError:  `error getting backup vs fake-backup, err: volumesnapshots.snapshot.storage.k8s.io "fake-backup" not found`,
+			},
+		},
+		{
+			name:              "all objects exist",
+			kubeClientObj:     []runtime.Object{backupPod, backupPVC, backupPV, nodeAgentPod},
+			snapshotClientObj: []runtime.Object{backupVS, backupVSC},
+			expected: &ExposeDiagnosis{
+				Pod: &PodDiagnosis{
+					Namespace: velerov1.DefaultNamespace,
+					Name:      "fake-backup",
+					Phase:     corev1api.PodPending,
+					NodeName:  "fake-node",
+					Conditions: []PodConditionDiagnosis{
+						{
+							Type:    corev1api.PodScheduled,
+							Status:  corev1api.ConditionTrue,
+							Message: "fake-pod-message",
+						},
+					},
+				},
+				NodeAgentRunning: true,
+				PVC: &PVCDiagnosis{
+					Namespace:  velerov1.DefaultNamespace,
+					Name:       "fake-backup",
+					Phase:      corev1api.ClaimBound,
+					VolumeName: "fake-pv",
+				},
+				PV: &PVDiagnosis{
+					Name:  "fake-pv",
+					Phase: corev1api.VolumeBound,
+				},
+				VS: &SnapshotDiagnosis{
+					Namespace:  velerov1.DefaultNamespace,
+					Name:       "fake-backup",
+					BoundTo:    vscName,
+					ReadyToUse: true,
+				},
+				VSC: &SnapshotDiagnosis{
+					Name:       vscName,
+					Handle:     snapshotHandle,
+					ReadyToUse: true,
+				},
+			},
+		},
+		{
+			name:              "node agent not running",
+			kubeClientObj:     []runtime.Object{backupPod, backupPVC, backupPV},
+			snapshotClientObj: []runtime.Object{backupVS, backupVSC},
+			expected: &ExposeDiagnosis{
+				Pod: &PodDiagnosis{
+					Namespace: velerov1.DefaultNamespace,
+					Name:      "fake-backup",
+					Phase:     corev1api.PodPending,
+					NodeName:  "fake-node",
+					Conditions: []PodConditionDiagnosis{
+						{
+							Type:    corev1api.PodScheduled,
+							Status:  corev1api.ConditionTrue,
+							Message: "fake-pod-message",
+						},
+					},
+				},
+				NodeAgentError: "node-agent is not running in node fake-node, err: daemonset pod not found in running state in node fake-node",
+				PVC: &PVCDiagnosis{
+					Namespace:  velerov1.DefaultNamespace,
+					Name:       "fake-backup",
+					Phase:      corev1api.ClaimBound,
+					VolumeName: "fake-pv",
+				},
+				PV: &PVDiagnosis{
+					Name:  "fake-pv",
+					Phase: corev1api.VolumeBound,
+				},
+				VS: &SnapshotDiagnosis{
+					Namespace:  velerov1.DefaultNamespace,
+					Name:       "fake-backup",
+					BoundTo:    vscName,
+					ReadyToUse: true,
+				},
+				VSC: &SnapshotDiagnosis{
+					Name:       vscName,
+					Handle:     snapshotHandle,
+					ReadyToUse: true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(tt.kubeClientObj...)
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(tt.snapshotClientObj...)
+			e := &csiSnapshotExposer{
+				kubeClient:        fakeKubeClient,
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			diag, err := e.DiagnoseExposeStructured(context.Background(), ownerObject)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, diag)
+			assert.Equal(t, diag.String(), e.DiagnoseExpose(context.Background(), ownerObject))
+
+			// the objects are formatted in the same text as the diagnose helpers
+			if diag.Pod != nil {
+				assert.Contains(t, diag.String(), kube.DiagnosePod(backupPod))
+				assert.Contains(t, diag.String(), kube.DiagnosePVC(backupPVC))
+				assert.Contains(t, diag.String(), kube.DiagnosePV(backupPV))
+				assert.Contains(t, diag.String(), csi.DiagnoseVS(backupVS))
+				assert.Contains(t, diag.String(), csi.DiagnoseVSC(backupVSC))
+			}
+		})
+	}
+}
+
+func TestDiagnoseExposeWaitForFirstConsumer(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodPending,
+			Conditions: []corev1api.PodCondition{
+				{
+					Type:    corev1api.PodScheduled,
+					Status:  corev1api.ConditionFalse,
+					Reason:  corev1api.PodReasonUnschedulable,
+					Message: "0/3 nodes are available: 3 node(s) didn't match Pod's node affinity/selector",
+				},
+			},
+		},
+	}
+
+	storageClass := func(name string, mode storagev1api.VolumeBindingMode) *storagev1api.StorageClass {
+		return &storagev1api.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			VolumeBindingMode: &mode,
+		}
+	}
+
+	backupPVC := func(storageClass string, phase corev1api.PersistentVolumeClaimPhase) *corev1api.PersistentVolumeClaim {
+		return &corev1api.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1.DefaultNamespace,
+				Name:      "fake-backup",
+			},
+			Spec: corev1api.PersistentVolumeClaimSpec{
+				StorageClassName: &storageClass,
+			},
+			Status: corev1api.PersistentVolumeClaimStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	waitingMessage := "PVC velero/fake-backup waits for the first consumer by storage class fake-wffc-sc, it is not bound until the backup pod is scheduled\n"
+	unschedulableMessage := "Backup pod velero/fake-backup is unschedulable, reason Unschedulable, message 0/3 nodes are available: 3 node(s) didn't match Pod's node affinity/selector\n"
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		expectWaiting bool
+	}{
+		{
+			name: "pending pvc of wait for first consumer storage class",
+			kubeClientObj: []runtime.Object{
+				backupPod,
+				backupPVC("fake-wffc-sc", corev1api.ClaimPending),
+				storageClass("fake-wffc-sc", storagev1api.VolumeBindingWaitForFirstConsumer),
+			},
+			expectWaiting: true,
+		},
+		{
+			name: "pending pvc of immediate storage class",
+			kubeClientObj: []runtime.Object{
+				backupPod,
+				backupPVC("fake-sc", corev1api.ClaimPending),
+				storageClass("fake-sc", storagev1api.VolumeBindingImmediate),
+			},
+		},
+		{
+			name: "storage class not found",
+			kubeClientObj: []runtime.Object{
+				backupPod,
+				backupPVC("fake-wffc-sc", corev1api.ClaimPending),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &csiSnapshotExposer{
+				kubeClient:        fake.NewSimpleClientset(test.kubeClientObj...),
+				csiSnapshotClient: snapshotFake.NewSimpleClientset().SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			diag, err := e.DiagnoseExposeStructured(context.Background(), ownerObject)
+			require.NoError(t, err)
+			require.NotNil(t, diag.PVC)
+			assert.Equal(t, test.expectWaiting, diag.PVC.WaitForFirstConsumer)
+
+			text := diag.String()
+			if test.expectWaiting {
+				assert.Equal(t, "fake-wffc-sc", diag.PVC.StorageClass)
+				assert.Contains(t, text, waitingMessage)
+				assert.Contains(t, text, unschedulableMessage)
+			} else {
+				assert.NotContains(t, text, "waits for the first consumer")
+				assert.NotContains(t, text, "is unschedulable")
+			}
+		})
+	}
+}
+
+func TestCleanUp(t *testing.T) {
+	backup := &velerov1.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1.SchemeGroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			UID:       "fake-uid",
+		},
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+	}
+
+	backupVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+	}
+
+	sourceVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-ns",
+			Name:      "fake-vs",
+		},
+	}
+
+	tests := []struct {
+		name               string
+		opts               []CSISnapshotExposerOption
+		serially           bool
+		expectedConcurrent bool
+	}{
+		{
+			name:               "concurrent",
+			opts:               []CSISnapshotExposerOption{WithCleanUpConcurrency(true)},
+			expectedConcurrent: true,
+		},
+		{
+			name:     "serially by default",
+			serially: true,
+		},
+		{
+			name:     "serially",
+			opts:     []CSISnapshotExposerOption{WithCleanUpConcurrency(false)},
+			serially: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(backupPod, backupPVC)
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(backupVS, sourceVS)
+
+			var lock sync.Mutex
+			deleted := []string{}
+			recordDelete := func(action clientTesting.Action) {
+				lock.Lock()
+				defer lock.Unlock()
+				deleted = append(deleted, action.GetResource().Resource+"/"+action.(clientTesting.DeleteAction).GetName())
+			}
+
+			sourceVSDeleted := make(chan struct{})
+			concurrent := false
+
+			fakeKubeClient.Fake.PrependReactor("delete", "pods", func(action clientTesting.Action) (bool, runtime.Object, error) {
+				if !test.serially {
+					select {
+					case <-sourceVSDeleted:
+						concurrent = true
+					case <-time.After(5 * time.Second):
+					}
+				}
+
+				recordDelete(action)
+				return false, nil, nil
+			})
+
+			fakeKubeClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action clientTesting.Action) (bool, runtime.Object, error) {
+				recordDelete(action)
+				return false, nil, nil
+			})
+
+			fakeSnapshotClient.Fake.PrependReactor("delete", "volumesnapshots", func(action clientTesting.Action) (bool, runtime.Object, error) {
+				recordDelete(action)
+				if action.GetNamespace() == sourceVS.Namespace {
+					close(sourceVSDeleted)
+				}
+
+				return false, nil, nil
+			})
+
+			exposer := NewCSISnapshotExposer(fakeKubeClient, fakeSnapshotClient.SnapshotV1(), velerotest.NewLogger(), test.opts...)
+
+			ownerObject := corev1api.ObjectReference{
+				Kind:       backup.Kind,
+				Namespace:  backup.Namespace,
+				Name:       backup.Name,
+				UID:        backup.UID,
+				APIVersion: backup.APIVersion,
+			}
+
+			exposer.CleanUp(context.Background(), ownerObject, sourceVS.Name, sourceVS.Namespace)
+
+			assert.Equal(t, test.expectedConcurrent, concurrent)
+			assert.ElementsMatch(t, []string{"pods/fake-backup", "persistentvolumeclaims/fake-backup", "volumesnapshots/fake-backup", "volumesnapshots/fake-vs"}, deleted)
+			assert.Less(t, slices.Index(deleted, "persistentvolumeclaims/fake-backup"), slices.Index(deleted, "volumesnapshots/fake-backup"))
+
+			if test.serially {
+				assert.Equal(t, []string{"pods/fake-backup", "persistentvolumeclaims/fake-backup", "volumesnapshots/fake-backup", "volumesnapshots/fake-vs"}, deleted)
+			}
+		})
+	}
+}
+
+// ctxAwareKubeClient fails the deletion of PVCs once the context is done, as the real client does
+type ctxAwareKubeClient struct {
+	kubernetes.Interface
+}
+
+func (c *ctxAwareKubeClient) CoreV1() corev1client.CoreV1Interface {
+	return &ctxAwareCoreV1Client{c.Interface.CoreV1()}
+}
+
+type ctxAwareCoreV1Client struct {
+	corev1client.CoreV1Interface
+}
+
+func (c *ctxAwareCoreV1Client) PersistentVolumeClaims(namespace string) corev1client.PersistentVolumeClaimInterface {
+	return &ctxAwarePVCClient{c.CoreV1Interface.PersistentVolumeClaims(namespace)}
+}
+
+type ctxAwarePVCClient struct {
+	corev1client.PersistentVolumeClaimInterface
+}
+
+func (c *ctxAwarePVCClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return c.PersistentVolumeClaimInterface.Delete(ctx, name, opts)
+}
+
+// ctxAwareSnapshotClient fails the deletion of VSs once the context is done, as the real client does
+type ctxAwareSnapshotClient struct {
+	snapshotter.SnapshotV1Interface
+}
+
+func (c *ctxAwareSnapshotClient) VolumeSnapshots(namespace string) snapshotter.VolumeSnapshotInterface {
+	return &ctxAwareVSClient{c.SnapshotV1Interface.VolumeSnapshots(namespace)}
+}
+
+type ctxAwareVSClient struct {
+	snapshotter.VolumeSnapshotInterface
+}
+
+func (c *ctxAwareVSClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return c.VolumeSnapshotInterface.Delete(ctx, name, opts)
+}
+
+func TestWithCleanUpTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []CSISnapshotExposerOption
+		expected time.Duration
+	}{
+		{
+			name:     "default timeout",
+			expected: time.Minute,
+		},
+		{
+			name:     "custom timeout",
+			opts:     []CSISnapshotExposerOption{WithCleanUpTimeout(5 * time.Minute)},
+			expected: 5 * time.Minute,
+		},
+		{
+			name:     "non-positive timeout falls back to default",
+			opts:     []CSISnapshotExposerOption{WithCleanUpTimeout(0)},
+			expected: time.Minute,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), test.opts...).(*csiSnapshotExposer)
+			assert.Equal(t, test.expected, e.getCleanUpTimeout())
+		})
+	}
+
+	assert.Equal(t, defaultCleanUpTimeout, (&csiSnapshotExposer{}).getCleanUpTimeout())
+}
+
+func TestCleanUpWaitPodDeletion(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	podGVR := corev1api.SchemeGroupVersion.WithResource("pods")
+
+	tests := []struct {
+		name            string
+		opts            []CSISnapshotExposerOption
+		expectedPodGone bool
+	}{
+		{
+			name: "not wait by default",
+		},
+		{
+			name:            "wait for pod deletion",
+			opts:            []CSISnapshotExposerOption{WithCleanUpWaitPodDeletion()},
+			expectedPodGone: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupPod := &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ownerObject.Namespace,
+					Name:      ownerObject.Name,
+					Labels:    map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)},
+				},
+			}
+
+			fakeKubeClient := fake.NewSimpleClientset(backupPod)
+
+			// the pod terminates for a while after the deletion is issued
+			fakeKubeClient.PrependReactor("delete", "pods", func(action clientTesting.Action) (bool, runtime.Object, error) {
+				go func() {
+					time.Sleep(200 * time.Millisecond)
+					fakeKubeClient.Tracker().Delete(podGVR, ownerObject.Namespace, ownerObject.Name)
+				}()
+
+				return true, nil, nil
+			})
+
+			e := NewCSISnapshotExposer(fakeKubeClient, snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), test.opts...)
+			e.CleanUp(context.Background(), ownerObject, "fake-vs", "fake-ns")
+
+			_, err := fakeKubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			if test.expectedPodGone {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExposeCleanUpWithCanceledContext(t *testing.T) {
+	vscName := "fake-vsc"
+	snapshotHandle := "fake-handle"
+	var restoreSize int64 = 123456
+
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &vscName,
+			},
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+			RestoreSize:                    resource.NewQuantity(restoreSize, ""),
+		},
+	}
+
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy: snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:         "fake-driver",
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			RestoreSize:    &restoreSize,
+			SnapshotHandle: &snapshotHandle,
+		},
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fakeKubeClient := fake.NewSimpleClientset(daemonSet)
+	fakeSnapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj)
+
+	// the parent context is canceled in the middle of the expose, e.g., by the shutdown of the controller
+	fakeKubeClient.Fake.PrependReactor("create", "pods", func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+		cancel()
+		return true, nil, context.Canceled
+	})
+
+	exposer := csiSnapshotExposer{
+		kubeClient:        &ctxAwareKubeClient{fakeKubeClient},
+		csiSnapshotClient: &ctxAwareSnapshotClient{fakeSnapshotClient.SnapshotV1()},
+		log:               velerotest.NewLogger(),
+	}
+
+	err := exposer.Expose(ctx, ownerObject, &CSISnapshotExposeParam{
+		SnapshotName:     "fake-vs",
+		SourceNamespace:  "fake-ns",
+		AccessMode:       AccessModeFileSystem,
+		OperationTimeout: time.Millisecond,
+		ExposeTimeout:    time.Millisecond,
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Error(t, ctx.Err())
+
+	_, err = fakeKubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	_, err = fakeSnapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestCleanUpVerifiesOwner(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "DataUpload",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-du",
+		UID:       "fake-uid",
+	}
+
+	controlledBy := func(uid types.UID) []metav1.OwnerReference {
+		if uid == "" {
+			return nil
+		}
+
+		return []metav1.OwnerReference{
+			{
+				APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+				Kind:       "DataUpload",
+				Name:       ownerObject.Name,
+				UID:        uid,
+				Controller: boolptr.True(),
+			},
+		}
+	}
+
+	ownerLabels := func(uid types.UID) map[string]string {
+		if uid == "" {
+			return nil
+		}
+
+		return map[string]string{exposeOwnerUIDLabel: string(uid)}
+	}
+
+	snapshotHandle := "fake-handle"
+
+	tests := []struct {
+		name            string
+		objectOwnerUID  types.UID
+		expectedDeleted bool
+	}{
+		{
+			name:            "objects of the owner are deleted",
+			objectOwnerUID:  "fake-uid",
+			expectedDeleted: true,
+		},
+		{
+			name:           "objects of another owner are kept",
+			objectOwnerUID: "other-uid",
+		},
+		{
+			name:            "objects without owner info are deleted",
+			expectedDeleted: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(
+				&corev1api.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:       ownerObject.Namespace,
+						Name:            ownerObject.Name,
+						OwnerReferences: controlledBy(test.objectOwnerUID),
+					},
+				},
+				&corev1api.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:       ownerObject.Namespace,
+						Name:            ownerObject.Name,
+						OwnerReferences: controlledBy(test.objectOwnerUID),
+					},
+				},
+			)
+
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(
+				&snapshotv1api.VolumeSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: ownerObject.Namespace,
+						Name:      ownerObject.Name,
+						Labels:    ownerLabels(test.objectOwnerUID),
+					},
+				},
+				&snapshotv1api.VolumeSnapshotContent{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   ownerObject.Name,
+						Labels: ownerLabels(test.objectOwnerUID),
+					},
+					Spec: snapshotv1api.VolumeSnapshotContentSpec{
+						DeletionPolicy: snapshotv1api.VolumeSnapshotContentRetain,
+						Source: snapshotv1api.VolumeSnapshotContentSource{
+							SnapshotHandle: &snapshotHandle,
+						},
+					},
+				},
+			)
+
+			exposer := NewCSISnapshotExposer(fakeKubeClient, fakeSnapshotClient.SnapshotV1(), velerotest.NewLogger(), WithCleanUpConcurrency(false))
+			exposer.CleanUp(context.Background(), ownerObject, "", "")
+
+			_, podErr := fakeKubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			_, pvcErr := fakeKubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			_, vsErr := fakeSnapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			_, vscErr := fakeSnapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+
+			for _, err := range []error{podErr, pvcErr, vsErr, vscErr} {
+				if test.expectedDeleted {
+					assert.True(t, apierrors.IsNotFound(err))
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestCleanUpSummaryEvent(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	now := metav1.Now()
+	terminatingVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         ownerObject.Namespace,
+			Name:              ownerObject.Name,
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"fake-finalizer"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		vsName        string
+		snapshotObj   []runtime.Object
+		snapReactors  []reactor
+		noRecorder    bool
+		expectedEvent string
+	}{
+		{
+			name:          "all deleted",
+			vsName:        "fake-vs",
+			expectedEvent: "Normal Expose-Cleaned-Up Clean up finished, deleted [pod velero/fake-backup pvc velero/fake-backup vs velero/fake-backup vsc fake-backup vs fake-ns/fake-vs], still present []",
+		},
+		{
+			name:        "backup vs is terminating",
+			snapshotObj: []runtime.Object{terminatingVS},
+			snapReactors: []reactor{
+				{
+					verb:     "delete",
+					resource: "volumesnapshots",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, nil
+					},
+				},
+			},
+			expectedEvent: "Warning Expose-Cleaned-Up Clean up finished, deleted [pod velero/fake-backup pvc velero/fake-backup vsc fake-backup], still present [vs velero/fake-backup (terminating)]",
+		},
+		{
+			name: "check fails",
+			snapReactors: []reactor{
+				{
+					verb:     "get",
+					resource: "volumesnapshotcontents",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-get-error")
+					},
+				},
+			},
+			expectedEvent: "Warning Expose-Cleaned-Up Clean up finished, deleted [pod velero/fake-backup pvc velero/fake-backup vs velero/fake-backup], still present [vsc fake-backup (unknown)], " +
+				"errors: [error to remove finalizer from backup VSC fake-backup: error to get backup VSC fake-backup: fake-get-error, error to get backup VSC fake-backup: fake-get-error]",
+		},
+		{
+			name:   "delete fails",
+			vsName: "fake-vs",
+			snapReactors: []reactor{
+				{
+					verb:     "delete",
+					resource: "volumesnapshots",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-delete-error")
+					},
+				},
+			},
+			expectedEvent: "Warning Expose-Cleaned-Up Clean up finished, deleted [pod velero/fake-backup pvc velero/fake-backup vs velero/fake-backup vsc fake-backup vs fake-ns/fake-vs], still present [], " +
+				"errors: [error to delete backup VS velero/fake-backup: fake-delete-error, error to delete source VS fake-ns/fake-vs: fake-delete-error]",
+		},
+		{
+			name:       "no event recorder",
+			noRecorder: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset()
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(test.snapshotObj...)
+			for _, reactor := range test.snapReactors {
+				fakeSnapshotClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
+
+			fakeRecorder := record.NewFakeRecorder(10)
+
+			exposer := csiSnapshotExposer{
+				kubeClient:        fakeKubeClient,
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			if !test.noRecorder {
+				exposer.eventRecorder = fakeRecorder
+			}
+
+			exposer.CleanUp(context.Background(), ownerObject, test.vsName, "fake-ns")
+
+			if test.noRecorder {
+				assert.Empty(t, fakeRecorder.Events)
+				return
+			}
+
+			require.Len(t, fakeRecorder.Events, 1)
+			assert.Equal(t, test.expectedEvent, <-fakeRecorder.Events)
+		})
+	}
+}
+
+func TestValidateSourceVolumeMode(t *testing.T) {
+	blockMode := corev1api.PersistentVolumeBlock
+	filesystemMode := corev1api.PersistentVolumeFilesystem
+
+	vs := func(pvcName string) *snapshotv1api.VolumeSnapshot {
+		vs := &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-ns",
+				Name:      "fake-vs",
+			},
+		}
+
+		if pvcName != "" {
+			vs.Spec.Source.PersistentVolumeClaimName = &pvcName
+		}
+
+		return vs
+	}
+
+	vsc := func(mode *corev1api.PersistentVolumeMode) *snapshotv1api.VolumeSnapshotContent {
+		return &snapshotv1api.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-vsc",
+			},
+			Spec: snapshotv1api.VolumeSnapshotContentSpec{
+				SourceVolumeMode: mode,
+			},
+		}
+	}
+
+	sourcePVC := func(mode *corev1api.PersistentVolumeMode) *corev1api.PersistentVolumeClaim {
+		return &corev1api.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-ns",
+				Name:      "fake-pvc",
+			},
+			Spec: corev1api.PersistentVolumeClaimSpec{
+				VolumeMode: mode,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		vs            *snapshotv1api.VolumeSnapshot
+		vsc           *snapshotv1api.VolumeSnapshotContent
+		kubeClientObj []runtime.Object
+		accessMode    string
+		err           string
+	}{
+		{
+			name:       "block source mode from vsc matches",
+			vs:         vs(""),
+			vsc:        vsc(&blockMode),
+			accessMode: AccessModeBlock,
+		},
+		{
+			name:       "block source mode from vsc mismatches",
+			vs:         vs(""),
+			vsc:        vsc(&blockMode),
+			accessMode: AccessModeFileSystem,
+			err:        "access mode by-file-system doesn't match volume mode Block of the source volume of VS fake-ns/fake-vs",
+		},
+		{
+			name:          "vsc mode takes precedence over source pvc",
+			vs:            vs("fake-pvc"),
+			vsc:           vsc(&filesystemMode),
+			kubeClientObj: []runtime.Object{sourcePVC(&blockMode)},
+			accessMode:    AccessModeFileSystem,
+		},
+		{
+			name:          "source mode from source pvc mismatches",
+			vs:            vs("fake-pvc"),
+			vsc:           vsc(nil),
+			kubeClientObj: []runtime.Object{sourcePVC(&blockMode)},
+			accessMode:    AccessModeFileSystem,
+			err:           "access mode by-file-system doesn't match volume mode Block of the source volume of VS fake-ns/fake-vs",
+		},
+		{
+			name:          "source pvc without volume mode is filesystem",
+			vs:            vs("fake-pvc"),
+			vsc:           vsc(nil),
+			kubeClientObj: []runtime.Object{sourcePVC(nil)},
+			accessMode:    AccessModeBlock,
+			err:           "access mode by-block-device doesn't match volume mode Filesystem of the source volume of VS fake-ns/fake-vs",
+		},
+		{
+			name:       "source mode is unknown",
+			vs:         vs("fake-pvc"),
+			vsc:        vsc(nil),
+			accessMode: AccessModeBlock,
+		},
+		{
+			name:       "unsupported access mode is not checked",
+			vs:         vs(""),
+			vsc:        vsc(&blockMode),
+			accessMode: "fake-mode",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &csiSnapshotExposer{
+				kubeClient:        fake.NewSimpleClientset(test.kubeClientObj...),
+				csiSnapshotClient: snapshotFake.NewSimpleClientset().SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			err := e.validateSourceVolumeMode(context.Background(), test.vs, test.vsc, test.accessMode, velerotest.NewLogger())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				assert.ErrorIs(t, err, ErrUnsupportedAccessMode)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDetectNodeOS(t *testing.T) {
+	pvcName := "fake-pvc"
+	vsWithoutPVC := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+	}
+
+	vs := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				PersistentVolumeClaimName: &pvcName,
+			},
+		},
+	}
+
+	pvc := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName,
+			Namespace: "fake-ns",
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv",
+		},
+	}
+
+	pvcWithSelectedNode := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName,
+			Namespace: "fake-ns",
+			Annotations: map[string]string{
+				kube.KubeAnnSelectedNode: "fake-windows-node",
+			},
+		},
+	}
+
+	pvWithOSAffinity := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv",
+		},
+		Spec: corev1api.PersistentVolumeSpec{
+			NodeAffinity: &corev1api.VolumeNodeAffinity{
+				Required: &corev1api.NodeSelector{
+					NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1api.NodeSelectorRequirement{
+								{
+									Key:      kube.NodeOSLabel,
+									Operator: corev1api.NodeSelectorOpIn,
+									Values:   []string{kube.NodeOSWindows},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pvWithHostAffinity := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv",
+		},
+		Spec: corev1api.PersistentVolumeSpec{
+			NodeAffinity: &corev1api.VolumeNodeAffinity{
+				Required: &corev1api.NodeSelector{
+					NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1api.NodeSelectorRequirement{
+								{
+									Key:      corev1api.LabelHostname,
+									Operator: corev1api.NodeSelectorOpIn,
+									Values:   []string{"fake-windows-node"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pvWithoutAffinity := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv",
+		},
+	}
+
+	windowsNode := &corev1api.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "fake-windows-node",
+			Labels: map[string]string{kube.NodeOSLabel: kube.NodeOSWindows},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		vs            *snapshotv1api.VolumeSnapshot
+		kubeClientObj []runtime.Object
+		expected      string
+	}{
+		{
+			name:     "no source pvc",
+			vs:       vsWithoutPVC,
+			expected: kube.NodeOSLinux,
+		},
+		{
+			name:     "source pvc not found",
+			vs:       vs,
+			expected: kube.NodeOSLinux,
+		},
+		{
+			name:          "os from pv affinity",
+			vs:            vs,
+			kubeClientObj: []runtime.Object{pvc, pvWithOSAffinity},
+			expected:      kube.NodeOSWindows,
+		},
+		{
+			name:          "os from the node in pv affinity",
+			vs:            vs,
+			kubeClientObj: []runtime.Object{pvc, pvWithHostAffinity, windowsNode},
+			expected:      kube.NodeOSWindows,
+		},
+		{
+			name:          "os from the selected node",
+			vs:            vs,
+			kubeClientObj: []runtime.Object{pvcWithSelectedNode, windowsNode},
+			expected:      kube.NodeOSWindows,
+		},
+		{
+			name:          "os could not be determined",
+			vs:            vs,
+			kubeClientObj: []runtime.Object{pvc, pvWithoutAffinity},
+			expected:      kube.NodeOSLinux,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(test.kubeClientObj...),
+				log:        velerotest.NewLogger(),
+			}
+
+			assert.Equal(t, test.expected, exposer.detectNodeOS(context.Background(), test.vs, exposer.log))
+		})
+	}
+}
+
+func TestCreateBackupPodAlreadyExists(t *testing.T) {
+	backup := &velerov1.Backup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1.SchemeGroupVersion.String(),
+			Kind:       "Backup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+			UID:       "fake-uid",
+		},
+	}
+
+	ownerObject := corev1api.ObjectReference{
+		Kind:       backup.Kind,
+		Namespace:  backup.Namespace,
+		Name:       backup.Name,
+		UID:        backup.UID,
+		APIVersion: backup.APIVersion,
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: backup.Namespace,
+			Name:      backup.Name,
+		},
+	}
+
+	existingPod := func(ownerUID string, claimName string) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: backup.Namespace,
+				Name:      backup.Name,
+				Labels:    map[string]string{"existing": "true"},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: backup.APIVersion,
+						Kind:       backup.Kind,
+						Name:       backup.Name,
+						UID:        types.UID(ownerUID),
+						Controller: boolptr.True(),
+					},
+				},
+			},
+			Spec: corev1api.PodSpec{
+				Volumes: []corev1api.Volume{
+					{
+						Name: string(backup.UID),
+						VolumeSource: corev1api.VolumeSource{
+							PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{
+								ClaimName: claimName,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		kubeClientObj    []runtime.Object
+		adopt            bool
+		err              string
+		expectedExisting bool
+	}{
+		{
+			name:          "first run",
+			kubeClientObj: []runtime.Object{daemonSet},
+			adopt:         true,
+		},
+		{
+			name:             "adopt existing pod of the same owner, adopt is not enabled",
+			kubeClientObj:    []runtime.Object{daemonSet, existingPod(string(backup.UID), backupPVC.Name)},
+			expectedExisting: true,
+		},
+		{
+			name:          "recreate pod of the same owner with mismatched volume, adopt is not enabled",
+			kubeClientObj: []runtime.Object{daemonSet, existingPod(string(backup.UID), "other-pvc")},
+		},
+		{
+			name: "recreate terminated pod of the same owner, adopt is not enabled",
+			kubeClientObj: []runtime.Object{daemonSet, func() *corev1api.Pod {
+				pod := existingPod(string(backup.UID), backupPVC.Name)
+				pod.Status.Phase = corev1api.PodFailed
+				return pod
+			}()},
+		},
+		{
+			name:          "already exists with mismatched owner, adopt is not enabled",
+			kubeClientObj: []runtime.Object{daemonSet, existingPod("other-uid", backupPVC.Name)},
+			err:           "pods \"fake-backup\" already exists",
+		},
+		{
+			name:             "adopt existing pod",
+			kubeClientObj:    []runtime.Object{daemonSet, existingPod(string(backup.UID), backupPVC.Name)},
+			adopt:            true,
+			expectedExisting: true,
+		},
+		{
+			name:          "recreate pod with mismatched owner",
+			kubeClientObj: []runtime.Object{daemonSet, existingPod("other-uid", backupPVC.Name)},
+			adopt:         true,
+		},
+		{
+			name:          "recreate pod with mismatched volume",
+			kubeClientObj: []runtime.Object{daemonSet, existingPod(string(backup.UID), "other-pvc")},
+			adopt:         true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(test.kubeClientObj...),
+				log:        velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout:       time.Second,
+				AdoptExistingBackupPod: test.adopt,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedExisting, pod.Labels["existing"] == "true")
+			assert.True(t, isBackupPodCompatible(pod, ownerObject, backupPVC.Name, string(backup.UID)))
+		})
+	}
+}
+
+func TestCreateBackupVSConflict(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	vsClass := "fake-vs-class"
+	sourceVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-ns",
+			Name:      "fake-vs",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			VolumeSnapshotClassName: &vsClass,
+		},
+	}
+
+	staleVS := func(deleting bool) *snapshotv1api.VolumeSnapshot {
+		vs := &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  ownerObject.Namespace,
+				Name:       ownerObject.Name,
+				Finalizers: []string{"fake-finalizer"},
+			},
+		}
+
+		if deleting {
+			vs.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+
+		return vs
+	}
+
+	backupVSCName := ownerObject.Name
+	ownedVS := func(deleting bool) *snapshotv1api.VolumeSnapshot {
+		vs := staleVS(deleting)
+		vs.Labels = map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)}
+		vs.Spec = snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &backupVSCName,
+			},
+			VolumeSnapshotClassName: &vsClass,
+		}
+
+		return vs
+	}
+
+	tests := []struct {
+		name            string
+		existing        *snapshotv1api.VolumeSnapshot
+		clearAfter      time.Duration
+		timeout         time.Duration
+		expectedAdopted bool
+		expectedErr     string
+	}{
+		{
+			name:    "no existing vs",
+			timeout: time.Second,
+		},
+		{
+			name:       "stale vs clears after a delay",
+			existing:   staleVS(true),
+			clearAfter: 200 * time.Millisecond,
+			timeout:    5 * time.Second,
+		},
+		{
+			name:        "stale vs never clears",
+			existing:    staleVS(true),
+			timeout:     300 * time.Millisecond,
+			expectedErr: "timeout to wait stale backup VS fake-backup deleted",
+		},
+		{
+			name:        "existing vs is not being deleted",
+			existing:    staleVS(false),
+			timeout:     5 * time.Second,
+			expectedErr: "backup VS fake-backup already exists and is not being deleted",
+		},
+		{
+			name:            "existing vs of the same owner is adopted",
+			existing:        ownedVS(false),
+			timeout:         5 * time.Second,
+			expectedAdopted: true,
+		},
+		{
+			name:        "existing vs of the same owner is being deleted",
+			existing:    ownedVS(true),
+			timeout:     300 * time.Millisecond,
+			expectedErr: "timeout to wait stale backup VS fake-backup deleted",
+		},
+	}
+
+	staleBackupVSPollInterval = 50 * time.Millisecond
+	defer func() {
+		staleBackupVSPollInterval = time.Second
+	}()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := []runtime.Object{}
+			if tt.existing != nil {
+				objs = append(objs, tt.existing)
+			}
+
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(objs...)
+			e := &csiSnapshotExposer{
+				kubeClient:        fake.NewSimpleClientset(),
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			if tt.clearAfter > 0 {
+				go func() {
+					time.Sleep(tt.clearAfter)
+					fakeSnapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).Delete(context.Background(), ownerObject.Name, metav1.DeleteOptions{})
+				}()
+			}
+
+			vs, adopted, err := e.createBackupVS(context.Background(), ownerObject, ownerObject.Namespace, sourceVS, nil, "", tt.timeout)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAdopted, adopted)
+			assert.Nil(t, vs.DeletionTimestamp)
+			assert.Equal(t, ownerObject.Name, *vs.Spec.Source.VolumeSnapshotContentName)
+			assert.Equal(t, vsClass, *vs.Spec.VolumeSnapshotClassName)
+		})
+	}
+}
+
+func TestCreateBackupVSCSourceVolumeMode(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	backupVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+			UID:       "fake-vs-uid",
+		},
+	}
+
+	blockMode := corev1api.PersistentVolumeBlock
+
+	tests := []struct {
+		name         string
+		sourceMode   *corev1api.PersistentVolumeMode
+		expectedMode *corev1api.PersistentVolumeMode
+	}{
+		{
+			name: "source volume mode is not set",
+		},
+		{
+			name:         "block source volume mode is carried over",
+			sourceMode:   &blockMode,
+			expectedMode: &blockMode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshotHandle := "fake-handle"
+			sourceVSC := &snapshotv1api.VolumeSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-vsc",
+				},
+				Spec: snapshotv1api.VolumeSnapshotContentSpec{
+					Driver:           "fake-driver",
+					SourceVolumeMode: tt.sourceMode,
+				},
+				Status: &snapshotv1api.VolumeSnapshotContentStatus{
+					SnapshotHandle: &snapshotHandle,
+				},
+			}
+
+			e := &csiSnapshotExposer{
+				kubeClient:        fake.NewSimpleClientset(),
+				csiSnapshotClient: snapshotFake.NewSimpleClientset().SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			vsc, adopted, err := e.createBackupVSC(context.Background(), ownerObject, sourceVSC, backupVS, nil, "", snapshotv1api.VolumeSnapshotContentDelete)
+			require.NoError(t, err)
+			assert.False(t, adopted)
+			assert.Equal(t, tt.expectedMode, vsc.Spec.SourceVolumeMode)
+			assert.Equal(t, snapshotHandle, *vsc.Spec.Source.SnapshotHandle)
+		})
+	}
+}
+
+func TestDiagnoseBackupExposures(t *testing.T) {
+	backupPod := func(ownerName string, backupName string, phase corev1api.PodPhase, withOwner bool) *corev1api.Pod {
+		pod := &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1.DefaultNamespace,
+				Name:      ownerName,
+				Labels: map[string]string{
+					podGroupLabel:            podGroupSnapshot,
+					velerov1.BackupNameLabel: backupName,
+				},
+			},
+			Status: corev1api.PodStatus{
+				Phase: phase,
+			},
+		}
+
+		if withOwner {
+			pod.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+					Kind:       "DataUpload",
+					Name:       ownerName,
+					UID:        types.UID(ownerName + "-uid"),
+					Controller: boolptr.True(),
+				},
+			}
+		}
+
+		return pod
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-du-2",
+		},
+		Status: corev1api.PersistentVolumeClaimStatus{
+			Phase: corev1api.ClaimPending,
+		},
+	}
+
+	crossNamespacePod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero-movers",
+			Name:      "fake-du-5",
+			Labels: map[string]string{
+				podGroupLabel:             podGroupSnapshot,
+				velerov1.BackupNameLabel:  "fake-backup",
+				velerov1.DataUploadLabel:  "fake-du-5",
+				exposeOwnerUIDLabel:       "fake-du-5-uid",
+				exposeOwnerNamespaceLabel: velerov1.DefaultNamespace,
+			},
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodPending,
+		},
+	}
+
+	otherPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-other-pod",
+			Labels: map[string]string{
+				velerov1.BackupNameLabel: "fake-backup",
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		kubeReactors  []reactor
+		namespace     string
+		backupLabel   string
+		canceled      bool
+		expected      map[string][]string
+		err           string
+	}{
+		{
+			name: "several exposures in varying states",
+			kubeClientObj: []runtime.Object{
+				backupPod("fake-du-1", "fake-backup", corev1api.PodRunning, true),
+				backupPod("fake-du-2", "fake-backup", corev1api.PodPending, true),
+				backupPod("fake-du-3", "other-backup", corev1api.PodPending, true),
+				backupPod("fake-du-4", "fake-backup", corev1api.PodPending, false),
+				backupPVC,
+				otherPod,
+			},
+			backupLabel: velerov1.BackupNameLabel + "=fake-backup",
+			expected: map[string][]string{
+				"fake-du-1": {
+					"Pod velero/fake-du-1, phase Running, node name \n",
+					"error getting backup pvc fake-du-1",
+				},
+				"fake-du-2": {
+					"Pod velero/fake-du-2, phase Pending, node name \n",
+					"PVC velero/fake-du-2, phase Pending, binding to \n",
+				},
+			},
+		},
+		{
+			name: "exposure in target namespace",
+			kubeClientObj: []runtime.Object{
+				crossNamespacePod,
+				backupPod("fake-du-6", "fake-backup", corev1api.PodPending, false),
+			},
+			namespace:   "velero-movers",
+			backupLabel: velerov1.BackupNameLabel + "=fake-backup",
+			expected: map[string][]string{
+				"fake-du-5": {
+					"Pod velero-movers/fake-du-5, phase Pending, node name \n",
+					"error getting backup pvc fake-du-5",
+				},
+			},
+		},
+		{
+			name:          "no exposure",
+			kubeClientObj: []runtime.Object{otherPod},
+			backupLabel:   velerov1.BackupNameLabel + "=fake-backup",
+			expected:      map[string][]string{},
+		},
+		{
+			name: "context canceled",
+			kubeClientObj: []runtime.Object{
+				backupPod("fake-du-1", "fake-backup", corev1api.PodRunning, true),
+				backupPod("fake-du-2", "fake-backup", corev1api.PodPending, true),
+			},
+			backupLabel: velerov1.BackupNameLabel + "=fake-backup",
+			canceled:    true,
+			err:         "error to diagnose backup exposures: context canceled",
+		},
+		{
+			name:        "invalid label",
+			backupLabel: "=fake-backup",
+			err:         "error to parse backup label =fake-backup",
+		},
+		{
+			name:        "list pods fail",
+			backupLabel: velerov1.BackupNameLabel + "=fake-backup",
+			kubeReactors: []reactor{
+				{
+					verb:     "list",
+					resource: "pods",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-list-error")
+					},
+				},
+			},
+			err: "error to list backup pods with label velero.io/backup-name=fake-backup: fake-list-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+			for _, reactor := range test.kubeReactors {
+				fakeKubeClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
+
+			e := NewCSISnapshotExposer(fakeKubeClient, snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger()).(*csiSnapshotExposer)
+
+			namespace := test.namespace
+			if namespace == "" {
+				namespace = velerov1.DefaultNamespace
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if test.canceled {
+				cancel()
+			}
+
+			diags, err := e.DiagnoseBackupExposures(ctx, namespace, test.backupLabel)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				assert.Empty(t, diags)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, diags, len(test.expected))
+
+			for owner, expected := range test.expected {
+				require.Contains(t, diags, owner)
+				for _, text := range expected {
+					assert.Contains(t, diags[owner], text)
+				}
+			}
+		})
+	}
+}
+
+func TestDiagnoseExposePodLogs(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	backupPod := func(containerStatuses ...corev1api.ContainerStatus) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1.DefaultNamespace,
+				Name:      "fake-backup",
+			},
+			Status: corev1api.PodStatus{
+				Phase:             corev1api.PodFailed,
+				ContainerStatuses: containerStatuses,
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		pod               *corev1api.Pod
+		tailLines         int64
+		expectedLines     int64
+		expectedLogs      string
+		expectedLogsError string
+	}{
+		{
+			name:              "container never started",
+			pod:               backupPod(corev1api.ContainerStatus{Name: "fake-uid", State: corev1api.ContainerState{Waiting: &corev1api.ContainerStateWaiting{}}}),
+			expectedLines:     defaultDiagnosePodLogLines,
+			expectedLogsError: "no logs available for backup pod fake-backup, container fake-uid has never started",
+		},
+		{
+			name:              "no container status",
+			pod:               backupPod(),
+			tailLines:         10,
+			expectedLines:     10,
+			expectedLogsError: "no logs available for backup pod fake-backup, container fake-uid has never started",
+		},
+		{
+			name:          "container terminated",
+			pod:           backupPod(corev1api.ContainerStatus{Name: "fake-uid", State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{}}}),
+			tailLines:     10,
+			expectedLines: 10,
+			expectedLogs:  "fake logs",
+		},
+		{
+			name:          "container restarted",
+			pod:           backupPod(corev1api.ContainerStatus{Name: "fake-uid", LastTerminationState: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{}}}),
+			expectedLines: defaultDiagnosePodLogLines,
+			expectedLogs:  "fake logs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(tt.pod)
+			e := NewCSISnapshotExposer(fakeKubeClient, snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), WithDiagnosePodLogs(tt.tailLines)).(*csiSnapshotExposer)
+			assert.Equal(t, tt.expectedLines, e.diagnosePodLogLines)
+
+			diag, err := e.DiagnoseExposeStructured(context.Background(), ownerObject)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLogs, diag.PodLogs)
+			assert.Equal(t, tt.expectedLogsError, diag.PodLogsError)
+
+			text := e.DiagnoseExpose(context.Background(), ownerObject)
+			if tt.expectedLogsError != "" {
+				assert.Contains(t, text, tt.expectedLogsError+"\n")
+			} else {
+				assert.Contains(t, text, "Backup pod logs:\n"+tt.expectedLogs+"\n")
+			}
+		})
+	}
+}
+
+func TestMergeSnapshotLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		managed  map[string]string
+		source   map[string]string
+		expected map[string]string
+	}{
+		{
+			name: "no labels",
+		},
+		{
+			name: "reserved keys are skipped",
+			source: map[string]string{
+				"cost-center":                       "fake-cost-center",
+				"velero.io/backup-name":             "fake-backup",
+				"backup.velero.io/backup-volumes":   "fake-volume",
+				"example.com/velero.io":             "fake-value",
+				"notvelero.io/fake-key":             "fake-value",
+				"velero.io.example.com/fake-key":    "fake-value",
+				"velero.io-without-slash-is-a-name": "fake-value",
+			},
+			expected: map[string]string{
+				"cost-center":                       "fake-cost-center",
+				"example.com/velero.io":             "fake-value",
+				"notvelero.io/fake-key":             "fake-value",
+				"velero.io.example.com/fake-key":    "fake-value",
+				"velero.io-without-slash-is-a-name": "fake-value",
+			},
+		},
+		{
+			name: "managed labels are not overwritten",
+			managed: map[string]string{
+				"fake-key": "managed-value",
+			},
+			source: map[string]string{
+				"fake-key":    "source-value",
+				"cost-center": "fake-cost-center",
+			},
+			expected: map[string]string{
+				"fake-key":    "managed-value",
+				"cost-center": "fake-cost-center",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mergeSnapshotLabels(tt.managed, tt.source))
+		})
+	}
+}
+
+func TestWaitBackupVSBound(t *testing.T) {
+	backupVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1.DefaultNamespace,
+			Name:      "fake-backup",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		boundTo     string
+		bindAfter   time.Duration
+		timeout     time.Duration
+		expectedErr string
+	}{
+		{
+			name:      "bound after a delay",
+			boundTo:   "fake-backup",
+			bindAfter: 100 * time.Millisecond,
+			timeout:   5 * time.Second,
+		},
+		{
+			name:        "never bound",
+			timeout:     200 * time.Millisecond,
+			expectedErr: "timeout to wait backup VS fake-backup bound to VSC fake-backup",
+		},
+		{
+			name:        "bound to another vsc",
+			boundTo:     "other-vsc",
+			timeout:     5 * time.Second,
+			expectedErr: "backup VS fake-backup is bound to VSC other-vsc instead of the backup VSC fake-backup",
+		},
+	}
+
+	backupVSBindPollInterval = 20 * time.Millisecond
+	defer func() {
+		backupVSBindPollInterval = time.Second
+	}()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(backupVS.DeepCopy())
+			e := &csiSnapshotExposer{
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			if tt.boundTo != "" {
+				bind := func() {
+					updated := backupVS.DeepCopy()
+					updated.Status = &snapshotv1api.VolumeSnapshotStatus{
+						BoundVolumeSnapshotContentName: &tt.boundTo,
+					}
+					fakeSnapshotClient.SnapshotV1().VolumeSnapshots(updated.Namespace).UpdateStatus(context.Background(), updated, metav1.UpdateOptions{})
+				}
+
+				if tt.bindAfter > 0 {
+					go func() {
+						time.Sleep(tt.bindAfter)
+						bind()
+					}()
+				} else {
+					bind()
+				}
+			}
+
+			err := e.waitBackupVSBound(context.Background(), backupVS, "fake-backup", tt.timeout)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetExposeProgress(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	backupPod := func(nodeName string, phase corev1api.PodPhase) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ownerObject.Namespace,
+				Name:      ownerObject.Name,
+			},
+			Spec: corev1api.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: corev1api.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	backupPVC := func(volumeName string, phase corev1api.PersistentVolumeClaimPhase) *corev1api.PersistentVolumeClaim {
+		return &corev1api.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ownerObject.Namespace,
+				Name:      ownerObject.Name,
+			},
+			Spec: corev1api.PersistentVolumeClaimSpec{
+				VolumeName: volumeName,
+			},
+			Status: corev1api.PersistentVolumeClaimStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		expected      *ExposeProgress
+		expectedErr   string
+	}{
+		{
+			name:        "pod not found",
+			expectedErr: "error to get backup pod fake-backup: pods \"fake-backup\" not found",
+		},
+		{
+			name:          "pvc not found",
+			kubeClientObj: []runtime.Object{backupPod("", corev1api.PodPending)},
+			expectedErr:   "error to get backup pvc fake-backup: persistentvolumeclaims \"fake-backup\" not found",
+		},
+		{
+			name:          "pod pending",
+			kubeClientObj: []runtime.Object{backupPod("", corev1api.PodPending), backupPVC("", corev1api.ClaimPending)},
+			expected: &ExposeProgress{
+				Phase:      ExposePhasePodPending,
+				Percentage: 25,
+			},
+		},
+		{
+			name:          "pvc binding",
+			kubeClientObj: []runtime.Object{backupPod("fake-node", corev1api.PodPending), backupPVC("", corev1api.ClaimPending)},
+			expected: &ExposeProgress{
+				Phase:      ExposePhasePVCBinding,
+				Percentage: 50,
+				NodeName:   "fake-node",
+			},
+		},
+		{
+			name:          "pvc bound",
+			kubeClientObj: []runtime.Object{backupPod("fake-node", corev1api.PodPending), backupPVC("fake-pv", corev1api.ClaimBound)},
+			expected: &ExposeProgress{
+				Phase:      ExposePhaseBound,
+				Percentage: 75,
+				NodeName:   "fake-node",
+				VolumeName: "fake-pv",
+			},
+		},
+		{
+			name:          "pvc bound before pod scheduled",
+			kubeClientObj: []runtime.Object{backupPod("", corev1api.PodPending), backupPVC("fake-pv", corev1api.ClaimBound)},
+			expected: &ExposeProgress{
+				Phase:      ExposePhaseBound,
+				Percentage: 75,
+				VolumeName: "fake-pv",
+			},
+		},
+		{
+			name:          "pod running",
+			kubeClientObj: []runtime.Object{backupPod("fake-node", corev1api.PodRunning), backupPVC("fake-pv", corev1api.ClaimBound)},
+			expected: &ExposeProgress{
+				Phase:      ExposePhasePodRunning,
+				Percentage: 100,
+				NodeName:   "fake-node",
+				VolumeName: "fake-pv",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(tt.kubeClientObj...),
+				log:        velerotest.NewLogger(),
+			}
+
+			progress, err := e.GetExposeProgress(context.Background(), ownerObject)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, progress)
+		})
+	}
+}
+
+func TestSnapshotControllerPreflight(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	deployment := func(replicas int32, readyReplicas int32) *appsv1api.Deployment {
+		return &appsv1api.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kube-system",
+				Name:      "snapshot-controller",
+			},
+			Spec: appsv1api.DeploymentSpec{
+				Replicas: &replicas,
+			},
+			Status: appsv1api.DeploymentStatus{
+				ReadyReplicas: readyReplicas,
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		expectedErr   string
+		expectedKind  error
+	}{
+		{
+			name:         "deployment not found",
+			expectedErr:  "snapshot controller deployment kube-system/snapshot-controller is not found",
+			expectedKind: ErrSnapshotControllerNotRunning,
+		},
+		{
+			name:          "scaled down to zero",
+			kubeClientObj: []runtime.Object{deployment(0, 0)},
+			expectedErr:   "snapshot controller deployment kube-system/snapshot-controller is scaled down to zero",
+			expectedKind:  ErrSnapshotControllerNotRunning,
+		},
+		{
+			name:          "no ready replica",
+			kubeClientObj: []runtime.Object{deployment(2, 0)},
+			expectedErr:   "snapshot controller deployment kube-system/snapshot-controller has no ready replica",
+			expectedKind:  ErrSnapshotControllerNotRunning,
+		},
+		{
+			name:          "snapshot controller is running",
+			kubeClientObj: []runtime.Object{deployment(2, 1)},
+			expectedErr:   "error wait volume snapshot ready: error to get VolumeSnapshot /fake-vs: volumesnapshots.snapshot.storage.k8s.io \"fake-vs\" not found",
+			expectedKind:  ErrSnapshotNotReady,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewCSISnapshotExposer(fake.NewSimpleClientset(tt.kubeClientObj...), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(),
+				WithSnapshotControllerPreflight("kube-system", "snapshot-controller"))
+
+			err := e.Expose(context.Background(), ownerObject, &CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			})
+			require.EqualError(t, err, tt.expectedErr)
+			assert.ErrorIs(t, err, tt.expectedKind)
+		})
+	}
+}
+
+func TestMaxExposePodsPerNode(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	exposePod := func(name string, group string, node string, phase corev1api.PodPhase) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1.DefaultNamespace,
+				Name:      name,
+				Labels:    map[string]string{podGroupLabel: group},
+			},
+			Spec: corev1api.PodSpec{
+				NodeName: node,
+			},
+			Status: corev1api.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	exposePods := []runtime.Object{
+		daemonSet,
+		exposePod("pod-1", podGroupSnapshot, "node-1", corev1api.PodRunning),
+		exposePod("pod-2", podGroupSnapshot, "node-1", corev1api.PodRunning),
+		exposePod("pod-3", podGroupGenericRestore, "node-2", corev1api.PodRunning),
+		exposePod("pod-4", podGroupSnapshot, "node-2", corev1api.PodPending),
+		exposePod("pod-5", podGroupSnapshot, "node-3", corev1api.PodRunning),
+		exposePod("pod-6", podGroupSnapshot, "node-3", corev1api.PodSucceeded),
+		exposePod("pod-7", podGroupSnapshot, "", corev1api.PodPending),
+		exposePod("pod-8", podGroupSnapshot, "", corev1api.PodPending),
+	}
+
+	exclusion := func(nodes ...string) corev1api.NodeSelectorRequirement {
+		return corev1api.NodeSelectorRequirement{
+			Key:      "metadata.name",
+			Operator: corev1api.NodeSelectorOpNotIn,
+			Values:   nodes,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		kubeClientObj    []runtime.Object
+		maxPodsPerNode   int
+		affinities       []*kube.LoadAffinity
+		expectedAffinity *corev1api.Affinity
+	}{
+		{
+			name:           "limit is not set",
+			kubeClientObj:  exposePods,
+			maxPodsPerNode: 0,
+		},
+		{
+			name:           "no node over the limit",
+			kubeClientObj:  exposePods,
+			maxPodsPerNode: 3,
+		},
+		{
+			name:           "nodes at the limit are excluded",
+			kubeClientObj:  exposePods,
+			maxPodsPerNode: 2,
+			expectedAffinity: &corev1api.Affinity{
+				NodeAffinity: &corev1api.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+							{
+								MatchFields: []corev1api.NodeSelectorRequirement{exclusion("node-1", "node-2")},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "nodes over the limit are excluded",
+			kubeClientObj:  exposePods,
+			maxPodsPerNode: 1,
+			expectedAffinity: &corev1api.Affinity{
+				NodeAffinity: &corev1api.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+							{
+								MatchFields: []corev1api.NodeSelectorRequirement{exclusion("node-1", "node-2", "node-3")},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "exclusion is added to the existing affinity",
+			kubeClientObj:  exposePods,
+			maxPodsPerNode: 2,
+			affinities: []*kube.LoadAffinity{
+				{
+					NodeSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"kubernetes.io/os": "linux"},
+					},
+				},
+			},
+			expectedAffinity: &corev1api.Affinity{
+				NodeAffinity: &corev1api.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+						NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+							{
+								MatchExpressions: []corev1api.NodeSelectorRequirement{
+									{
+										Key:      "kubernetes.io/os",
+										Operator: corev1api.NodeSelectorOpIn,
+										Values:   []string{"linux"},
+									},
+								},
+								MatchFields: []corev1api.NodeSelectorRequirement{exclusion("node-1", "node-2")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(test.kubeClientObj...),
+				log:        velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout:     time.Second,
+				Affinities:           test.affinities,
+				MaxExposePodsPerNode: test.maxPodsPerNode,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedAffinity, pod.Spec.Affinity)
+		})
+	}
+}
+
+func TestAvoidNodesAtAttachLimit(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	csiNode := func(node string, driver string, count *int32) *storagev1api.CSINode {
+		return &storagev1api.CSINode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: node,
+			},
+			Spec: storagev1api.CSINodeSpec{
+				Drivers: []storagev1api.CSINodeDriver{
+					{
+						Name:        driver,
+						NodeID:      node,
+						Allocatable: &storagev1api.VolumeNodeResources{Count: count},
+					},
+				},
+			},
+		}
+	}
+
+	attachment := func(name string, driver string, node string) *storagev1api.VolumeAttachment {
+		return &storagev1api.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: storagev1api.VolumeAttachmentSpec{
+				Attacher: driver,
+				NodeName: node,
+			},
+		}
+	}
+
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	objects := []runtime.Object{
+		daemonSet,
+		csiNode("node-1", "fake-driver", int32Ptr(2)),
+		csiNode("node-2", "fake-driver", int32Ptr(1)),
+		csiNode("node-3", "fake-driver", int32Ptr(3)),
+		csiNode("node-4", "fake-driver", nil),
+		csiNode("node-5", "other-driver", int32Ptr(1)),
+		attachment("va-1", "fake-driver", "node-1"),
+		attachment("va-2", "fake-driver", "node-1"),
+		attachment("va-3", "fake-driver", "node-2"),
+		attachment("va-4", "fake-driver", "node-3"),
+		attachment("va-5", "other-driver", "node-3"),
+		attachment("va-6", "fake-driver", "node-4"),
+		attachment("va-7", "other-driver", "node-5"),
+	}
+
+	exclusion := func(nodes ...string) *corev1api.Affinity {
+		return &corev1api.Affinity{
+			NodeAffinity: &corev1api.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1api.NodeSelector{
+					NodeSelectorTerms: []corev1api.NodeSelectorTerm{
+						{
+							MatchFields: []corev1api.NodeSelectorRequirement{
+								{
+									Key:      "metadata.name",
+									Operator: corev1api.NodeSelectorOpNotIn,
+									Values:   nodes,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		kubeClientObj    []runtime.Object
+		avoid            bool
+		driver           string
+		kubeReactors     []reactor
+		expectedAffinity *corev1api.Affinity
+	}{
+		{
+			name:          "not enabled",
+			kubeClientObj: objects,
+			driver:        "fake-driver",
+		},
+		{
+			name:          "driver is unknown",
+			kubeClientObj: objects,
+			avoid:         true,
+		},
+		{
+			name:          "no node at the limit",
+			kubeClientObj: []runtime.Object{daemonSet, csiNode("node-3", "fake-driver", int32Ptr(3))},
+			avoid:         true,
+			driver:        "fake-driver",
+		},
+		{
+			name:             "nodes at the limit are excluded",
+			kubeClientObj:    objects,
+			avoid:            true,
+			driver:           "fake-driver",
+			expectedAffinity: exclusion("node-1", "node-2"),
+		},
+		{
+			name:             "attach limit of other driver",
+			kubeClientObj:    objects,
+			avoid:            true,
+			driver:           "other-driver",
+			expectedAffinity: exclusion("node-5"),
+		},
+		{
+			name:          "list CSI nodes fail",
+			kubeClientObj: objects,
+			avoid:         true,
+			driver:        "fake-driver",
+			kubeReactors: []reactor{
+				{
+					verb:     "list",
+					resource: "csinodes",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-list-error")
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+			for _, reactor := range test.kubeReactors {
+				fakeKubeClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
+
+			exposer := csiSnapshotExposer{
+				kubeClient: fakeKubeClient,
+				log:        velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout:        time.Second,
+				AvoidNodesAtAttachLimit: test.avoid,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, test.driver, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedAffinity, pod.Spec.Affinity)
+		})
+	}
+}
+
+func TestNodeAgentAffinity(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	affinities := []*kube.LoadAffinity{
+		{
+			NodeSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"fake-zone": "zone-1",
+				},
+			},
+		},
+	}
+
+	nodeAgentTerm := corev1api.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"role": "node-agent"},
+		},
+		Namespaces:  []string{velerov1.DefaultNamespace},
+		TopologyKey: "kubernetes.io/hostname",
+	}
+
+	tests := []struct {
+		name                string
+		mode                NodeAgentAffinityMode
+		affinities          []*kube.LoadAffinity
+		expectedPodAffinity *corev1api.PodAffinity
+	}{
+		{
+			name: "default is off",
+		},
+		{
+			name: "off",
+			mode: NodeAgentAffinityOff,
+		},
+		{
+			name: "required",
+			mode: NodeAgentAffinityRequired,
+			expectedPodAffinity: &corev1api.PodAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1api.PodAffinityTerm{nodeAgentTerm},
+			},
+		},
+		{
+			name: "preferred",
+			mode: NodeAgentAffinityPreferred,
+			expectedPodAffinity: &corev1api.PodAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1api.WeightedPodAffinityTerm{
+					{
+						Weight:          100,
+						PodAffinityTerm: nodeAgentTerm,
+					},
+				},
+			},
+		},
+		{
+			name:       "required with node affinity",
+			mode:       NodeAgentAffinityRequired,
+			affinities: affinities,
+			expectedPodAffinity: &corev1api.PodAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1api.PodAffinityTerm{nodeAgentTerm},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(daemonSet),
+				log:        velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout:  time.Second,
+				Affinities:        test.affinities,
+				NodeAgentAffinity: test.mode,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+			require.NoError(t, err)
+
+			if test.expectedPodAffinity == nil {
+				assert.Nil(t, pod.Spec.Affinity)
+				return
+			}
+
+			require.NotNil(t, pod.Spec.Affinity)
+			assert.Equal(t, test.expectedPodAffinity, pod.Spec.Affinity.PodAffinity)
+
+			var expectedNodeAffinity *corev1api.NodeAffinity
+			if affinity := kube.ToSystemAffinity(test.affinities); affinity != nil {
+				expectedNodeAffinity = affinity.NodeAffinity
+			}
+			assert.Equal(t, expectedNodeAffinity, pod.Spec.Affinity.NodeAffinity)
+		})
+	}
+
+	t.Run("unsupported mode", func(t *testing.T) {
+		exposer := csiSnapshotExposer{
+			kubeClient:        fake.NewSimpleClientset(),
+			csiSnapshotClient: snapshotFake.NewSimpleClientset().SnapshotV1(),
+			log:               velerotest.NewLogger(),
+		}
+
+		_, err := exposer.validateExpose(context.Background(), ownerObject, &CSISnapshotExposeParam{NodeAgentAffinity: "Always"}, velerotest.NewLogger())
+		require.EqualError(t, err, "unsupported node-agent affinity mode Always")
+		assert.ErrorIs(t, err, ErrInvalidExposeParam)
+	})
+}
+
+func TestBackupPodSchedulerName(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent-windows",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	affinities := []*kube.LoadAffinity{
+		{
+			NodeSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"fake-zone": "zone-1",
+				},
+			},
+		},
+	}
+
+	createPod := func(schedulerName string) *corev1api.Pod {
+		exposer := csiSnapshotExposer{
+			kubeClient: fake.NewSimpleClientset(daemonSet),
+			log:        velerotest.NewLogger(),
+		}
+
+		param := &CSISnapshotExposeParam{
+			OperationTimeout: time.Second,
+			Affinities:       affinities,
+			SchedulerName:    schedulerName,
+		}
+
+		pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSWindows, "", nil)
+		require.NoError(t, err)
+
+		return pod
+	}
+
+	defaultPod := createPod("")
+	assert.Empty(t, defaultPod.Spec.SchedulerName)
+
+	customPod := createPod("volcano")
+	assert.Equal(t, "volcano", customPod.Spec.SchedulerName)
+
+	require.NotNil(t, customPod.Spec.Affinity)
+	assert.Equal(t, defaultPod.Spec.Affinity, customPod.Spec.Affinity)
+	require.NotEmpty(t, customPod.Spec.Tolerations)
+	assert.Equal(t, defaultPod.Spec.Tolerations, customPod.Spec.Tolerations)
+	assert.Equal(t, defaultPod.Spec.NodeSelector, customPod.Spec.NodeSelector)
+}
+
+func TestBackupPodWindowsTolerations(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := func(name string) *appsv1api.DaemonSet {
+		return &appsv1api.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      name,
+			},
+			Spec: appsv1api.DaemonSetSpec{
+				Template: corev1api.PodTemplateSpec{
+					Spec: corev1api.PodSpec{
+						Containers: []corev1api.Container{
+							{
+								Name: "node-agent",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	defaultToleration := corev1api.Toleration{
+		Key:      "os",
+		Operator: "Equal",
+		Effect:   "NoSchedule",
+		Value:    "windows",
+	}
+
+	versionToleration := corev1api.Toleration{
+		Key:      "node.kubernetes.io/windows-build",
+		Operator: corev1api.TolerationOpEqual,
+		Value:    "10.0.20348",
+		Effect:   corev1api.TaintEffectNoSchedule,
+	}
+
+	tests := []struct {
+		name                string
+		nodeOS              string
+		windowsTolerations  []corev1api.Toleration
+		skipDefault         bool
+		expectedTolerations []corev1api.Toleration
+	}{
+		{
+			name:                "default windows toleration",
+			nodeOS:              kube.NodeOSWindows,
+			expectedTolerations: []corev1api.Toleration{defaultToleration},
+		},
+		{
+			name:                "windows tolerations are appended",
+			nodeOS:              kube.NodeOSWindows,
+			windowsTolerations:  []corev1api.Toleration{versionToleration},
+			expectedTolerations: []corev1api.Toleration{defaultToleration, versionToleration},
+		},
+		{
+			name:                "default windows toleration is skipped",
+			nodeOS:              kube.NodeOSWindows,
+			windowsTolerations:  []corev1api.Toleration{versionToleration},
+			skipDefault:         true,
+			expectedTolerations: []corev1api.Toleration{versionToleration},
+		},
+		{
+			name:                "linux pod is not affected",
+			nodeOS:              kube.NodeOSLinux,
+			windowsTolerations:  []corev1api.Toleration{versionToleration},
+			skipDefault:         true,
+			expectedTolerations: []corev1api.Toleration{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(daemonSet("node-agent"), daemonSet("node-agent-windows")),
+				log:        velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout:             time.Second,
+				WindowsTolerations:           test.windowsTolerations,
+				SkipDefaultWindowsToleration: test.skipDefault,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, test.nodeOS, "", nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedTolerations, pod.Spec.Tolerations)
+		})
+	}
+}
+
+func TestBackupPodAutomountServiceAccountToken(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					ServiceAccountName: "fake-sa",
+					Containers: []corev1api.Container{
+						{
+							Name:  "node-agent",
+							Image: "fake-image",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	createPod := func(automount *bool) *corev1api.Pod {
+		exposer := csiSnapshotExposer{
+			kubeClient: fake.NewSimpleClientset(daemonSet),
+			log:        velerotest.NewLogger(),
+		}
+
+		param := &CSISnapshotExposeParam{
+			OperationTimeout:             time.Second,
+			AutomountServiceAccountToken: automount,
+		}
+
+		pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+		require.NoError(t, err)
+
+		return pod
+	}
+
+	defaultPod := createPod(nil)
+	assert.Nil(t, defaultPod.Spec.AutomountServiceAccountToken)
+
+	disabledPod := createPod(boolptr.False())
+	require.NotNil(t, disabledPod.Spec.AutomountServiceAccountToken)
+	assert.False(t, *disabledPod.Spec.AutomountServiceAccountToken)
+
+	// the service account, image and volumes are still inherited from node-agent
+	assert.Equal(t, "fake-sa", disabledPod.Spec.ServiceAccountName)
+	assert.Equal(t, "fake-image", disabledPod.Spec.Containers[0].Image)
+	assert.Equal(t, defaultPod.Spec.Volumes, disabledPod.Spec.Volumes)
+	assert.Equal(t, defaultPod.Spec.Containers[0].VolumeMounts, disabledPod.Spec.Containers[0].VolumeMounts)
+}
+
+func TestBackupPodNodeName(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	affinities := []*kube.LoadAffinity{
+		{
+			NodeSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"fake-zone": "zone-1",
+				},
+			},
+		},
+	}
+
+	createPod := func(nodeName string) *corev1api.Pod {
+		exposer := csiSnapshotExposer{
+			kubeClient: fake.NewSimpleClientset(daemonSet),
+			log:        velerotest.NewLogger(),
+		}
+
+		param := &CSISnapshotExposeParam{
+			OperationTimeout: time.Second,
+			Affinities:       affinities,
+			NodeName:         nodeName,
+		}
+
+		pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+		require.NoError(t, err)
+
+		return pod
+	}
+
+	defaultPod := createPod("")
+	assert.Empty(t, defaultPod.Spec.NodeName)
+	assert.NotNil(t, defaultPod.Spec.Affinity)
+	assert.NotEmpty(t, defaultPod.Spec.TopologySpreadConstraints)
+
+	pinnedPod := createPod("fake-node")
+	assert.Equal(t, "fake-node", pinnedPod.Spec.NodeName)
+	assert.Nil(t, pinnedPod.Spec.Affinity)
+	assert.Empty(t, pinnedPod.Spec.TopologySpreadConstraints)
+	assert.Equal(t, defaultPod.Spec.NodeSelector, pinnedPod.Spec.NodeSelector)
+}
+
+func TestValidateBackupNode(t *testing.T) {
+	node := func(name string, labels map[string]string) *corev1api.Node {
+		return &corev1api.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		kubeClientObj   []runtime.Object
+		nodeOS          string
+		nodeOSLabelKeys []string
+		expectedErr     string
+	}{
+		{
+			name:          "linux node",
+			kubeClientObj: []runtime.Object{node("fake-node", map[string]string{kube.NodeOSLabel: kube.NodeOSLinux})},
+			nodeOS:        kube.NodeOSLinux,
+		},
+		{
+			name:        "node not found",
+			nodeOS:      kube.NodeOSLinux,
+			expectedErr: "node fake-node to run the backup pod doesn't exist",
+		},
+		{
+			name:          "node OS mismatch",
+			kubeClientObj: []runtime.Object{node("fake-node", map[string]string{kube.NodeOSLabel: kube.NodeOSWindows})},
+			nodeOS:        kube.NodeOSLinux,
+			expectedErr:   "node fake-node is labeled with kubernetes.io/os=windows, which doesn't match the OS linux of the backup pod",
+		},
+		{
+			name:            "custom node OS label key missing",
+			kubeClientObj:   []runtime.Object{node("fake-node", map[string]string{kube.NodeOSLabel: kube.NodeOSLinux})},
+			nodeOS:          kube.NodeOSLinux,
+			nodeOSLabelKeys: []string{kube.NodeOSLabel, "fake-os-key"},
+			expectedErr:     "node fake-node is labeled with fake-os-key=, which doesn't match the OS linux of the backup pod",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(test.kubeClientObj...),
+				log:        velerotest.NewLogger(),
+			}
+
+			err := e.validateBackupNode(context.Background(), "fake-node", test.nodeOS, test.nodeOSLabelKeys)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				assert.ErrorIs(t, err, ErrInvalidExposeParam)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBackupPodImagePullPolicy(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name:  "node-agent",
+							Image: "fake-image",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	createPod := func(policy corev1api.PullPolicy) *corev1api.Pod {
+		exposer := csiSnapshotExposer{
+			kubeClient: fake.NewSimpleClientset(daemonSet),
+			log:        velerotest.NewLogger(),
+		}
+
+		param := &CSISnapshotExposeParam{
+			OperationTimeout: time.Second,
+			ImagePullPolicy:  policy,
+		}
+
+		pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+		require.NoError(t, err)
+
+		return pod
+	}
+
+	assert.Equal(t, corev1api.PullNever, createPod("").Spec.Containers[0].ImagePullPolicy)
+	assert.Equal(t, corev1api.PullIfNotPresent, createPod(corev1api.PullIfNotPresent).Spec.Containers[0].ImagePullPolicy)
+	assert.Equal(t, corev1api.PullAlways, createPod(corev1api.PullAlways).Spec.Containers[0].ImagePullPolicy)
+}
+
+func TestBackupPodHostAliases(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	createPod := func(aliases []corev1api.HostAlias) *corev1api.Pod {
+		exposer := csiSnapshotExposer{
+			kubeClient: fake.NewSimpleClientset(daemonSet),
+			log:        velerotest.NewLogger(),
+		}
+
+		param := &CSISnapshotExposeParam{
+			OperationTimeout: time.Second,
+			HostAliases:      aliases,
+		}
+
+		pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+		require.NoError(t, err)
+
+		return pod
+	}
+
+	assert.Nil(t, createPod(nil).Spec.HostAliases)
+
+	aliases := []corev1api.HostAlias{
+		{
+			IP:        "10.0.0.10",
+			Hostnames: []string{"minio.local", "s3.local"},
+		},
+	}
+	assert.Equal(t, aliases, createPod(aliases).Spec.HostAliases)
+}
+
+func TestValidateHostAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		aliases []corev1api.HostAlias
+		err     string
+	}{
+		{
+			name: "no alias",
+		},
+		{
+			name: "valid aliases",
+			aliases: []corev1api.HostAlias{
+				{IP: "10.0.0.10", Hostnames: []string{"minio.local"}},
+				{IP: "fd00::10", Hostnames: []string{"s3.local"}},
+			},
+		},
+		{
+			name: "invalid IP",
+			aliases: []corev1api.HostAlias{
+				{IP: "minio.local", Hostnames: []string{"s3.local"}},
+			},
+			err: "invalid IP \"minio.local\" of host alias",
+		},
+		{
+			name: "no hostname",
+			aliases: []corev1api.HostAlias{
+				{IP: "10.0.0.10"},
+			},
+			err: "no hostname in host alias of IP 10.0.0.10",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateHostAliases(test.aliases)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBackupPodSeccompProfile(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := func(name string) *appsv1api.DaemonSet {
+		return &appsv1api.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      name,
+			},
+			Spec: appsv1api.DaemonSetSpec{
+				Template: corev1api.PodTemplateSpec{
+					Spec: corev1api.PodSpec{
+						Containers: []corev1api.Container{
+							{
+								Name: "node-agent",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	runtimeDefault := &corev1api.SeccompProfile{
+		Type: corev1api.SeccompProfileTypeRuntimeDefault,
+	}
+
+	createPod := func(profile *corev1api.SeccompProfile, spcNoRelabeling bool, nodeOS string) *corev1api.Pod {
+		exposer := csiSnapshotExposer{
+			kubeClient: fake.NewSimpleClientset(daemonSet("node-agent"), daemonSet("node-agent-windows")),
+			log:        velerotest.NewLogger(),
+		}
+
+		param := &CSISnapshotExposeParam{
+			OperationTimeout: time.Second,
+			SeccompProfile:   profile,
+		}
+
+		pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, spcNoRelabeling, nodeOS, "", nil)
+		require.NoError(t, err)
+
+		return pod
+	}
+
+	defaultPod := createPod(nil, false, kube.NodeOSLinux)
+	assert.Nil(t, defaultPod.Spec.SecurityContext.SeccompProfile)
+	assert.Nil(t, defaultPod.Spec.Containers[0].SecurityContext)
+
+	pod := createPod(runtimeDefault, true, kube.NodeOSLinux)
+	assert.Equal(t, runtimeDefault, pod.Spec.SecurityContext.SeccompProfile)
+	require.NotNil(t, pod.Spec.Containers[0].SecurityContext)
+	assert.Equal(t, runtimeDefault, pod.Spec.Containers[0].SecurityContext.SeccompProfile)
+
+	// the SELinux options of spcNoRelabeling are kept and inherited by the container
+	require.NotNil(t, pod.Spec.SecurityContext.SELinuxOptions)
+	assert.Equal(t, "spc_t", pod.Spec.SecurityContext.SELinuxOptions.Type)
+	assert.Nil(t, pod.Spec.Containers[0].SecurityContext.SELinuxOptions)
+
+	windowsPod := createPod(runtimeDefault, false, kube.NodeOSWindows)
+	assert.Nil(t, windowsPod.Spec.SecurityContext.SeccompProfile)
+	assert.Nil(t, windowsPod.Spec.Containers[0].SecurityContext)
+}
+
+func TestBackupPodSELinuxOptions(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := func(name string) *appsv1api.DaemonSet {
+		return &appsv1api.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      name,
+			},
+			Spec: appsv1api.DaemonSetSpec{
+				Template: corev1api.PodTemplateSpec{
+					Spec: corev1api.PodSpec{
+						Containers: []corev1api.Container{
+							{
+								Name: "node-agent",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	tests := []struct {
+		name            string
+		seLinuxOptions  *corev1api.SELinuxOptions
+		spcNoRelabeling bool
+		nodeOS          string
+		expected        *corev1api.SELinuxOptions
+	}{
+		{
+			name:   "not set",
+			nodeOS: kube.NodeOSLinux,
+		},
+		{
+			name:            "spc_t for spcNoRelabeling by default",
+			spcNoRelabeling: true,
+			nodeOS:          kube.NodeOSLinux,
+			expected:        &corev1api.SELinuxOptions{Type: "spc_t"},
+		},
+		{
+			name:           "custom options",
+			seLinuxOptions: &corev1api.SELinuxOptions{Role: "system_r", Level: "s0:c123,c456"},
+			nodeOS:         kube.NodeOSLinux,
+			expected:       &corev1api.SELinuxOptions{Role: "system_r", Level: "s0:c123,c456"},
+		},
+		{
+			name:            "custom level keeps spc_t for spcNoRelabeling",
+			seLinuxOptions:  &corev1api.SELinuxOptions{Level: "s0:c123,c456"},
+			spcNoRelabeling: true,
+			nodeOS:          kube.NodeOSLinux,
+			expected:        &corev1api.SELinuxOptions{Type: "spc_t", Level: "s0:c123,c456"},
+		},
+		{
+			name:            "custom type overrides spc_t",
+			seLinuxOptions:  &corev1api.SELinuxOptions{Type: "container_t", Level: "s0:c123,c456"},
+			spcNoRelabeling: true,
+			nodeOS:          kube.NodeOSLinux,
+			expected:        &corev1api.SELinuxOptions{Type: "container_t", Level: "s0:c123,c456"},
+		},
+		{
+			name:           "ignored for windows",
+			seLinuxOptions: &corev1api.SELinuxOptions{Level: "s0:c123,c456"},
+			nodeOS:         kube.NodeOSWindows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(daemonSet("node-agent"), daemonSet("node-agent-windows")),
+				log:        velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout: time.Second,
+				SELinuxOptions:   test.seLinuxOptions,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, test.spcNoRelabeling, test.spcNoRelabeling, test.nodeOS, "", nil)
+			require.NoError(t, err)
+
+			require.NotNil(t, pod.Spec.SecurityContext)
+			assert.Equal(t, test.expected, pod.Spec.SecurityContext.SELinuxOptions)
+		})
+	}
+}
+
+func TestBackupPodFSGroup(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := func(name string) *appsv1api.DaemonSet {
+		return &appsv1api.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "velero",
+				Name:      name,
+			},
+			Spec: appsv1api.DaemonSetSpec{
+				Template: corev1api.PodTemplateSpec{
+					Spec: corev1api.PodSpec{
+						Containers: []corev1api.Container{
+							{
+								Name: "node-agent",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	fsGroup := int64(1000)
+	onRootMismatch := corev1api.FSGroupChangeOnRootMismatch
+
+	tests := []struct {
+		name                string
+		fsGroup             *int64
+		fsGroupChangePolicy *corev1api.PodFSGroupChangePolicy
+		nodeOS              string
+		expectedFSGroup     *int64
+		expectedPolicy      *corev1api.PodFSGroupChangePolicy
+	}{
+		{
+			name:   "not set",
+			nodeOS: kube.NodeOSLinux,
+		},
+		{
+			name:            "fsGroup only",
+			fsGroup:         &fsGroup,
+			nodeOS:          kube.NodeOSLinux,
+			expectedFSGroup: &fsGroup,
+		},
+		{
+			name:                "fsGroup with OnRootMismatch",
+			fsGroup:             &fsGroup,
+			fsGroupChangePolicy: &onRootMismatch,
+			nodeOS:              kube.NodeOSLinux,
+			expectedFSGroup:     &fsGroup,
+			expectedPolicy:      &onRootMismatch,
+		},
+		{
+			name:                "ignored for windows",
+			fsGroup:             &fsGroup,
+			fsGroupChangePolicy: &onRootMismatch,
+			nodeOS:              kube.NodeOSWindows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(daemonSet("node-agent"), daemonSet("node-agent-windows")),
+				log:        velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout:    time.Second,
+				FSGroup:             test.fsGroup,
+				FSGroupChangePolicy: test.fsGroupChangePolicy,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, test.nodeOS, "", nil)
+			require.NoError(t, err)
+
+			require.NotNil(t, pod.Spec.SecurityContext)
+			assert.Equal(t, test.expectedFSGroup, pod.Spec.SecurityContext.FSGroup)
+			assert.Equal(t, test.expectedPolicy, pod.Spec.SecurityContext.FSGroupChangePolicy)
+		})
+	}
+}
+
+func TestValidateFSGroup(t *testing.T) {
+	fsGroup := int64(1000)
+	negativeFSGroup := int64(-1)
+	onRootMismatch := corev1api.FSGroupChangeOnRootMismatch
+	unsupportedPolicy := corev1api.PodFSGroupChangePolicy("fake-policy")
+
+	tests := []struct {
+		name         string
+		fsGroup      *int64
+		changePolicy *corev1api.PodFSGroupChangePolicy
+		err          string
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name:         "valid",
+			fsGroup:      &fsGroup,
+			changePolicy: &onRootMismatch,
+		},
+		{
+			name:    "negative fsGroup",
+			fsGroup: &negativeFSGroup,
+			err:     "invalid fsGroup -1 of backup pod",
+		},
+		{
+			name:         "unsupported change policy",
+			fsGroup:      &fsGroup,
+			changePolicy: &unsupportedPolicy,
+			err:          "unsupported fsGroup change policy fake-policy of backup pod",
+		},
+		{
+			name:         "change policy without fsGroup",
+			changePolicy: &onRootMismatch,
+			err:          "fsGroup change policy is specified without fsGroup",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateFSGroup(test.fsGroup, test.changePolicy)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSeccompProfile(t *testing.T) {
+	localhostProfile := "profiles/fake.json"
+	emptyProfile := ""
+
+	tests := []struct {
+		name        string
+		profile     *corev1api.SeccompProfile
+		expectedErr string
+	}{
+		{
+			name: "nil profile",
+		},
+		{
+			name:    "runtime default",
+			profile: &corev1api.SeccompProfile{Type: corev1api.SeccompProfileTypeRuntimeDefault},
+		},
+		{
+			name:    "localhost with profile",
+			profile: &corev1api.SeccompProfile{Type: corev1api.SeccompProfileTypeLocalhost, LocalhostProfile: &localhostProfile},
+		},
+		{
+			name:        "localhost without profile",
+			profile:     &corev1api.SeccompProfile{Type: corev1api.SeccompProfileTypeLocalhost, LocalhostProfile: &emptyProfile},
+			expectedErr: "localhost profile is required by seccomp profile type Localhost",
+		},
+		{
+			name:        "runtime default with localhost profile",
+			profile:     &corev1api.SeccompProfile{Type: corev1api.SeccompProfileTypeRuntimeDefault, LocalhostProfile: &localhostProfile},
+			expectedErr: "localhost profile is not allowed by seccomp profile type RuntimeDefault",
+		},
+		{
+			name:        "unsupported type",
+			profile:     &corev1api.SeccompProfile{Type: "fake-type"},
+			expectedErr: "unsupported seccomp profile type fake-type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSeccompProfile(tt.profile)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResolveVolumeSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		restoreSize  *resource.Quantity
+		sourceSize   int64
+		tolerance    int
+		failOnExceed bool
+		expectedSize int64
+		expectedErr  string
+	}{
+		{
+			name:         "no restore size",
+			sourceSize:   100,
+			tolerance:    10,
+			expectedSize: 100,
+		},
+		{
+			name:         "check disabled",
+			restoreSize:  resource.NewQuantity(50, ""),
+			sourceSize:   100,
+			expectedSize: 50,
+		},
+		{
+			name:         "source size unknown",
+			restoreSize:  resource.NewQuantity(50, ""),
+			tolerance:    10,
+			failOnExceed: true,
+			expectedSize: 50,
+		},
+		{
+			name:         "restore size smaller within tolerance",
+			restoreSize:  resource.NewQuantity(95, ""),
+			sourceSize:   100,
+			tolerance:    10,
+			failOnExceed: true,
+			expectedSize: 100,
+		},
+		{
+			name:         "restore size larger within tolerance",
+			restoreSize:  resource.NewQuantity(110, ""),
+			sourceSize:   100,
+			tolerance:    10,
+			failOnExceed: true,
+			expectedSize: 110,
+		},
+		{
+			name:         "beyond tolerance is warned",
+			restoreSize:  resource.NewQuantity(10, ""),
+			sourceSize:   100,
+			tolerance:    10,
+			expectedSize: 100,
+		},
+		{
+			name:         "beyond tolerance fails",
+			restoreSize:  resource.NewQuantity(150, ""),
+			sourceSize:   100,
+			tolerance:    10,
+			failOnExceed: true,
+			expectedErr:  "restore size 150 of the snapshot differs from the source volume size 100 by 50.0%, exceeding the tolerance 10%",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			param := &CSISnapshotExposeParam{
+				VolumeSize:                  *resource.NewQuantity(test.sourceSize, ""),
+				VolumeSizeMismatchTolerance: test.tolerance,
+				FailOnVolumeSizeMismatch:    test.failOnExceed,
+			}
+
+			size, err := resolveVolumeSize(test.restoreSize, param, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				assert.ErrorIs(t, err, ErrVolumeSizeMismatch)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSize, size.Value())
+		})
+	}
+}
+
+func TestRoundUpToMinVolumeSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		size         resource.Quantity
+		minSize      resource.Quantity
+		expectedSize resource.Quantity
+	}{
+		{
+			name:         "no min size",
+			size:         resource.MustParse("100Mi"),
+			expectedSize: resource.MustParse("100Mi"),
+		},
+		{
+			name:         "size below min size",
+			size:         resource.MustParse("100Mi"),
+			minSize:      resource.MustParse("1Gi"),
+			expectedSize: resource.MustParse("1Gi"),
+		},
+		{
+			name:         "size equal to min size",
+			size:         resource.MustParse("1024Mi"),
+			minSize:      resource.MustParse("1Gi"),
+			expectedSize: resource.MustParse("1Gi"),
+		},
+		{
+			name:         "size above min size",
+			size:         resource.MustParse("10Gi"),
+			minSize:      resource.MustParse("1Gi"),
+			expectedSize: resource.MustParse("10Gi"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			size := roundUpToMinVolumeSize(test.size, test.minSize, velerotest.NewLogger())
+			assert.Equal(t, 0, test.expectedSize.Cmp(size), "size %s", size.String())
+		})
+	}
+}
+
+func TestAddVolumeSizeOverhead(t *testing.T) {
+	tests := []struct {
+		name         string
+		size         resource.Quantity
+		overhead     resource.Quantity
+		expectedSize resource.Quantity
+		expectedErr  string
+	}{
+		{
+			name:         "no overhead",
+			size:         resource.MustParse("10Gi"),
+			expectedSize: resource.MustParse("10Gi"),
+		},
+		{
+			name:         "overhead is added",
+			size:         resource.MustParse("10Gi"),
+			overhead:     resource.MustParse("512Mi"),
+			expectedSize: resource.MustParse("10752Mi"),
+		},
+		{
+			name:         "overhead on unknown size",
+			overhead:     resource.MustParse("1Gi"),
+			expectedSize: resource.MustParse("1Gi"),
+		},
+		{
+			name:        "overflow",
+			size:        *resource.NewQuantity(math.MaxInt64-10, resource.BinarySI),
+			overhead:    *resource.NewQuantity(11, resource.BinarySI),
+			expectedErr: "volume size 9223372036854775797 plus the overhead 11 overflows",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			size, err := addVolumeSizeOverhead(test.size, test.overhead, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				assert.ErrorIs(t, err, ErrInvalidExposeParam)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 0, test.expectedSize.Cmp(size), "size %s", size.String())
+		})
+	}
+}
+
+func TestPlanExpose(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	vscName := "fake-vsc"
+	snapshotClass := "fake-snapshot-class"
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+			RestoreSize:                    resource.NewQuantity(123456, ""),
+		},
+	}
+
+	vsObjectNotBound := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+	}
+
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			Driver: "fake-driver",
+		},
+	}
+
+	vsClass := func(name string, driver string) *snapshotv1api.VolumeSnapshotClass {
+		return &snapshotv1api.VolumeSnapshotClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Driver: driver,
+		}
+	}
+
+	storageClass := func(name string) *storagev1api.StorageClass {
+		return &storagev1api.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		param             CSISnapshotExposeParam
+		snapshotClientObj []runtime.Object
+		kubeClientObj     []runtime.Object
+		expectedPlan      *ExposePlan
+		err               string
+		expectedErrKind   error
+	}{
+		{
+			name: "plan is resolved",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				StorageClass:    "fake-sc",
+				AccessMode:      AccessModeFileSystem,
+				NodeOS:          kube.NodeOSLinux,
+			},
+			snapshotClientObj: []runtime.Object{vsObject, vscObj, vsClass(snapshotClass, "fake-driver")},
+			kubeClientObj:     []runtime.Object{storageClass("fake-sc")},
+			expectedPlan: &ExposePlan{
+				SnapshotNamespace:         "fake-ns",
+				SnapshotName:              "fake-vs",
+				SnapshotReady:             true,
+				SnapshotContent:           vscName,
+				Driver:                    "fake-driver",
+				BackupVolumeSnapshotClass: snapshotClass,
+				BackupPVCStorageClass:     "fake-sc",
+				BackupPVCAccessMode:       corev1api.ReadWriteOnce,
+				VolumeMode:                corev1api.PersistentVolumeFilesystem,
+				RestoreSize:               *resource.NewQuantity(123456, ""),
+				VolumeSize:                *resource.NewQuantity(123456, ""),
+				NodeOS:                    kube.NodeOSLinux,
+			},
+		},
+		{
+			name: "plan with backupPVC config",
+			param: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				StorageClass:              "fake-sc",
+				AccessMode:                AccessModeBlock,
+				BackupVolumeSnapshotClass: "fake-backup-vs-class",
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-backup-sc",
+						ReadOnly:     true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{vsObject, vscObj, vsClass("fake-backup-vs-class", "fake-driver")},
+			kubeClientObj:     []runtime.Object{storageClass("fake-backup-sc")},
+			expectedPlan: &ExposePlan{
+				SnapshotNamespace:         "fake-ns",
+				SnapshotName:              "fake-vs",
+				SnapshotReady:             true,
+				SnapshotContent:           vscName,
+				Driver:                    "fake-driver",
+				BackupVolumeSnapshotClass: "fake-backup-vs-class",
+				BackupPVCStorageClass:     "fake-backup-sc",
+				BackupPVCAccessMode:       corev1api.ReadOnlyMany,
+				BackupPVCReadOnly:         true,
+				VolumeMode:                corev1api.PersistentVolumeBlock,
+				RestoreSize:               *resource.NewQuantity(123456, ""),
+				VolumeSize:                *resource.NewQuantity(123456, ""),
+				NodeOS:                    kube.NodeOSLinux,
+			},
+		},
+		{
+			name: "snapshot is not bound",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				AccessMode:      AccessModeFileSystem,
+				VolumeSize:      resource.MustParse("1Gi"),
+				NodeOS:          kube.NodeOSWindows,
+			},
+			snapshotClientObj: []runtime.Object{vsObjectNotBound, vsClass(snapshotClass, "fake-driver")},
+			expectedPlan: &ExposePlan{
+				SnapshotNamespace:         "fake-ns",
+				SnapshotName:              "fake-vs",
+				BackupVolumeSnapshotClass: snapshotClass,
+				BackupPVCAccessMode:       corev1api.ReadWriteOnce,
+				VolumeMode:                corev1api.PersistentVolumeFilesystem,
+				RestoreSize:               resource.MustParse("1Gi"),
+				VolumeSize:                resource.MustParse("1Gi"),
+				NodeOS:                    kube.NodeOSWindows,
+			},
+		},
+		{
+			name: "unsupported access mode",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				AccessMode:      "fake-mode",
+			},
+			snapshotClientObj: []runtime.Object{vsObject, vscObj},
+			err:               "unsupported access mode fake-mode",
+			expectedErrKind:   ErrUnsupportedAccessMode,
+		},
+		{
+			name: "snapshot not found",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				AccessMode:      AccessModeFileSystem,
+			},
+			err:             "error to get volume snapshot fake-ns/fake-vs: volumesnapshots.snapshot.storage.k8s.io \"fake-vs\" not found",
+			expectedErrKind: ErrSnapshotNotReady,
+		},
+		{
+			name: "snapshot class doesn't match the driver",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				AccessMode:      AccessModeFileSystem,
+			},
+			snapshotClientObj: []runtime.Object{vsObject, vscObj, vsClass(snapshotClass, "other-driver")},
+			err:               "driver other-driver of volume snapshot class fake-snapshot-class doesn't match driver fake-driver of the snapshot",
+			expectedErrKind:   ErrInvalidExposeParam,
+		},
+		{
+			name: "storage class not found",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				StorageClass:    "fake-sc",
+				AccessMode:      AccessModeFileSystem,
+			},
+			snapshotClientObj: []runtime.Object{vsObject, vscObj, vsClass(snapshotClass, "fake-driver")},
+			err:               "storage class fake-sc for backup PVC doesn't exist",
+			expectedErrKind:   ErrInvalidExposeParam,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient:        fake.NewSimpleClientset(test.kubeClientObj...),
+				csiSnapshotClient: snapshotFake.NewSimpleClientset(test.snapshotClientObj...).SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			plan, err := exposer.PlanExpose(context.Background(), ownerObject, &test.param)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				assert.ErrorIs(t, err, test.expectedErrKind)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPlan, plan)
+		})
+	}
+}
+
+func TestResolveExposePlan(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	vscName := "fake-vsc"
+	snapshotClass := "fake-snapshot-class"
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+			RestoreSize:                    resource.NewQuantity(123456, ""),
+		},
+	}
+
+	vsClass := &snapshotv1api.VolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: snapshotClass,
+		},
+		Driver: "fake-driver",
+	}
+
+	tests := []struct {
+		name              string
+		param             CSISnapshotExposeParam
+		snapshotClientObj []runtime.Object
+		expectedPlan      *ExposePlan
+		err               string
+		expectedErrKind   error
+	}{
+		{
+			name: "backup storage class is not required",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				StorageClass:    "fake-sc",
+				AccessMode:      AccessModeFileSystem,
+				NodeOS:          kube.NodeOSLinux,
+				BackupPVCConfig: map[string]nodeagent.BackupPVC{
+					"fake-sc": {
+						StorageClass: "fake-backup-sc",
+						ReadOnly:     true,
+					},
+				},
+			},
+			snapshotClientObj: []runtime.Object{vsObject, vsClass},
+			expectedPlan: &ExposePlan{
+				SnapshotNamespace:         "fake-ns",
+				SnapshotName:              "fake-vs",
+				SnapshotReady:             true,
+				SnapshotContent:           vscName,
+				BackupVolumeSnapshotClass: snapshotClass,
+				BackupPVCStorageClass:     "fake-backup-sc",
+				BackupPVCAccessMode:       corev1api.ReadOnlyMany,
+				BackupPVCReadOnly:         true,
+				VolumeMode:                corev1api.PersistentVolumeFilesystem,
+				RestoreSize:               *resource.NewQuantity(123456, ""),
+				VolumeSize:                *resource.NewQuantity(123456, ""),
+				NodeOS:                    kube.NodeOSLinux,
+			},
+		},
+		{
+			name: "unsupported access mode",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				AccessMode:      "fake-mode",
+			},
+			snapshotClientObj: []runtime.Object{vsObject},
+			err:               "unsupported access mode fake-mode",
+			expectedErrKind:   ErrUnsupportedAccessMode,
+		},
+		{
+			name: "snapshot class not found",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				AccessMode:      AccessModeFileSystem,
+				NodeOS:          kube.NodeOSLinux,
+			},
+			snapshotClientObj: []runtime.Object{vsObject},
+			err:               "volume snapshot class fake-snapshot-class of snapshot fake-ns/fake-vs doesn't exist, specify a default volume snapshot class to fall back",
+			expectedErrKind:   ErrSnapshotClassNotFound,
+		},
+		{
+			name: "snapshot class not found, overridden",
+			param: CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				NodeOS:                    kube.NodeOSLinux,
+				BackupVolumeSnapshotClass: "fake-backup-snapshot-class",
+			},
+			snapshotClientObj: []runtime.Object{
+				vsObject,
+				&snapshotv1api.VolumeSnapshotClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-backup-snapshot-class",
+					},
+					Driver: "fake-driver",
+				},
+			},
+			expectedPlan: &ExposePlan{
+				SnapshotNamespace:         "fake-ns",
+				SnapshotName:              "fake-vs",
+				SnapshotReady:             true,
+				SnapshotContent:           vscName,
+				BackupVolumeSnapshotClass: "fake-backup-snapshot-class",
+				BackupPVCAccessMode:       corev1api.ReadWriteOnce,
+				VolumeMode:                corev1api.PersistentVolumeFilesystem,
+				RestoreSize:               *resource.NewQuantity(123456, ""),
+				VolumeSize:                *resource.NewQuantity(123456, ""),
+				NodeOS:                    kube.NodeOSLinux,
+			},
+		},
+		{
+			name: "snapshot not found",
+			param: CSISnapshotExposeParam{
+				SnapshotName:    "fake-vs",
+				SourceNamespace: "fake-ns",
+				AccessMode:      AccessModeFileSystem,
+			},
+			err:             "error to get volume snapshot fake-ns/fake-vs: volumesnapshots.snapshot.storage.k8s.io \"fake-vs\" not found",
+			expectedErrKind: ErrSnapshotNotReady,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			exposer := csiSnapshotExposer{
+				kubeClient:        kubeClient,
+				csiSnapshotClient: snapshotFake.NewSimpleClientset(test.snapshotClientObj...).SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			plan, err := exposer.ResolveExposePlan(context.Background(), ownerObject, &test.param)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				assert.ErrorIs(t, err, test.expectedErrKind)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPlan, plan)
+
+			for _, action := range kubeClient.Actions() {
+				assert.NotEqual(t, "create", action.GetVerb())
+			}
+		})
+	}
+}
+
+func TestExposeDryRun(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	vscName := "fake-vsc"
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+		},
+	}
+
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy: snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:         "fake-driver",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		accessMode string
+		err        string
+	}{
+		{
+			name:       "valid expose",
+			accessMode: AccessModeFileSystem,
+		},
+		{
+			name:       "invalid expose",
+			accessMode: "fake-mode",
+			err:        "unsupported access mode fake-mode",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient:        fake.NewSimpleClientset(),
+				csiSnapshotClient: snapshotFake.NewSimpleClientset(vsObject, vscObj).SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			err := exposer.Expose(context.Background(), ownerObject, &CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       test.accessMode,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+				DryRun:           true,
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			vsList, err := exposer.csiSnapshotClient.VolumeSnapshots("").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Len(t, vsList.Items, 1)
+
+			vscList, err := exposer.csiSnapshotClient.VolumeSnapshotContents().List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			require.Len(t, vscList.Items, 1)
+			assert.Equal(t, snapshotv1api.VolumeSnapshotContentDelete, vscList.Items[0].Spec.DeletionPolicy)
+
+			pvcList, err := exposer.kubeClient.CoreV1().PersistentVolumeClaims("").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, pvcList.Items)
+
+			podList, err := exposer.kubeClient.CoreV1().Pods("").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, podList.Items)
+		})
+	}
+}
+
+func TestValidateExtraVolumes(t *testing.T) {
+	tests := []struct {
+		name         string
+		extraVolumes []corev1api.Volume
+		extraMounts  []corev1api.VolumeMount
+		err          string
+	}{
+		{
+			name: "no extra volume",
+		},
+		{
+			name:         "no conflict",
+			extraVolumes: []corev1api.Volume{{Name: "fake-volume"}},
+			extraMounts: []corev1api.VolumeMount{
+				{Name: "fake-volume", MountPath: "/fake-uid-other"},
+				{Name: "fake-volume", MountPath: "/credentials/fake-uid"},
+			},
+		},
+		{
+			name:         "volume name conflicts",
+			extraVolumes: []corev1api.Volume{{Name: "fake-uid"}},
+			err:          "extra volume fake-uid conflicts with the backup volume",
+		},
+		{
+			name:        "volume mount name conflicts",
+			extraMounts: []corev1api.VolumeMount{{Name: "fake-uid", MountPath: "/credentials"}},
+			err:         "extra volume mount fake-uid conflicts with the backup volume",
+		},
+		{
+			name:        "same path",
+			extraMounts: []corev1api.VolumeMount{{Name: "fake-volume", MountPath: "/fake-uid/"}},
+			err:         "mount path /fake-uid/ of extra volume fake-volume conflicts with the backup volume path /fake-uid",
+		},
+		{
+			name:        "path under the backup volume",
+			extraMounts: []corev1api.VolumeMount{{Name: "fake-volume", MountPath: "/fake-uid/credentials"}},
+			err:         "mount path /fake-uid/credentials of extra volume fake-volume conflicts with the backup volume path /fake-uid",
+		},
+		{
+			name:        "path hides the backup volume",
+			extraMounts: []corev1api.VolumeMount{{Name: "fake-volume", MountPath: "/"}},
+			err:         "mount path / of extra volume fake-volume conflicts with the backup volume path /fake-uid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateExtraVolumes(test.extraVolumes, test.extraMounts, "fake-uid")
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestAdoptOrphanedBackupPods(t *testing.T) {
+	dataUpload := func(name string, uid string, deleting bool) *velerov2alpha1.DataUpload {
+		du := &velerov2alpha1.DataUpload{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+				Kind:       "DataUpload",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1.DefaultNamespace,
+				Name:      name,
+				UID:       types.UID(uid),
+			},
+		}
+
+		if deleting {
+			du.Finalizers = []string{ExposeCleanUpFinalizer}
+			du.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+
+		return du
+	}
+
+	backupPod := func(name string, group string, node string, phase corev1api.PodPhase, owner *velerov2alpha1.DataUpload) *corev1api.Pod {
+		pod := &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: velerov1.DefaultNamespace,
+				Name:      name,
+				Labels:    map[string]string{podGroupLabel: group},
+			},
+			Spec: corev1api.PodSpec{
+				NodeName: node,
+			},
+			Status: corev1api.PodStatus{
+				Phase: phase,
+			},
+		}
+
+		if owner != nil {
+			pod.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: owner.APIVersion,
+					Kind:       owner.Kind,
+					Name:       owner.Name,
+					UID:        owner.UID,
+					Controller: boolptr.True(),
+				},
+			}
+		}
+
+		return pod
+	}
+
+	ownerRef := func(du *velerov2alpha1.DataUpload) corev1api.ObjectReference {
+		return corev1api.ObjectReference{
+			APIVersion: du.APIVersion,
+			Kind:       du.Kind,
+			Namespace:  du.Namespace,
+			Name:       du.Name,
+			UID:        du.UID,
+		}
+	}
+
+	liveOwner := dataUpload("du-live", "uid-live", false)
+	deletingOwner := dataUpload("du-deleting", "uid-deleting", true)
+	recreatedOwner := dataUpload("du-recreated", "uid-new", false)
+	goneOwner := dataUpload("du-gone", "uid-gone", false)
+	otherNodeOwner := dataUpload("du-other-node", "uid-other-node", false)
+	completedOwner := dataUpload("du-completed", "uid-completed", false)
+
+	staleRecreatedOwner := recreatedOwner.DeepCopy()
+	staleRecreatedOwner.UID = "uid-old"
+
+	crossNamespaceOwner := dataUpload("du-cross-namespace", "uid-cross-namespace", false)
+	crossNamespacePod := backupPod("du-cross-namespace", podGroupSnapshot, "fake-node", corev1api.PodRunning, nil)
+	crossNamespacePod.Namespace = "velero-movers"
+	crossNamespacePod.Labels[velerov1.DataUploadLabel] = crossNamespaceOwner.Name
+	crossNamespacePod.Labels[exposeOwnerUIDLabel] = string(crossNamespaceOwner.UID)
+	crossNamespacePod.Labels[exposeOwnerNamespaceLabel] = crossNamespaceOwner.Namespace
+
+	tests := []struct {
+		name           string
+		kubeClientObj  []runtime.Object
+		ownerObj       []runtime.Object
+		namespace      string
+		noOwnerClient  bool
+		expectedOwners []corev1api.ObjectReference
+		err            string
+	}{
+		{
+			name:          "owner client is not set",
+			noOwnerClient: true,
+			err:           "owner client is not set",
+		},
+		{
+			name:           "no backup pod",
+			expectedOwners: []corev1api.ObjectReference{},
+		},
+		{
+			name: "orphaned backup pods with live owners are adopted",
+			kubeClientObj: []runtime.Object{
+				backupPod("du-live", podGroupSnapshot, "fake-node", corev1api.PodRunning, liveOwner),
+				backupPod("du-deleting", podGroupSnapshot, "fake-node", corev1api.PodPending, deletingOwner),
+				backupPod("du-recreated", podGroupSnapshot, "fake-node", corev1api.PodRunning, staleRecreatedOwner),
+				backupPod("du-gone", podGroupSnapshot, "fake-node", corev1api.PodRunning, goneOwner),
+				backupPod("du-other-node", podGroupSnapshot, "other-node", corev1api.PodRunning, otherNodeOwner),
+				backupPod("du-completed", podGroupSnapshot, "fake-node", corev1api.PodSucceeded, completedOwner),
+				backupPod("restore-pod", podGroupGenericRestore, "fake-node", corev1api.PodRunning, liveOwner),
+				backupPod("no-owner", podGroupSnapshot, "fake-node", corev1api.PodRunning, nil),
 			},
-			Timeout: time.Second,
-			expectedResult: &ExposeResult{
-				ByPod: ExposeByPod{
-					HostingPod: backupPod,
-					VolumeName: string(backup.UID),
+			ownerObj: []runtime.Object{
+				liveOwner,
+				deletingOwner,
+				recreatedOwner,
+				otherNodeOwner,
+				completedOwner,
+			},
+			expectedOwners: []corev1api.ObjectReference{
+				ownerRef(deletingOwner),
+				ownerRef(liveOwner),
+			},
+		},
+		{
+			name: "orphaned backup pod in target namespace is adopted",
+			kubeClientObj: []runtime.Object{
+				crossNamespacePod,
+			},
+			ownerObj: []runtime.Object{
+				crossNamespaceOwner,
+			},
+			namespace: "velero-movers",
+			expectedOwners: []corev1api.ObjectReference{
+				ownerRef(crossNamespaceOwner),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := csiSnapshotExposer{
+				kubeClient: fake.NewSimpleClientset(test.kubeClientObj...),
+				log:        velerotest.NewLogger(),
+			}
+
+			if !test.noOwnerClient {
+				exposer.ownerClient = velerotest.NewFakeControllerRuntimeClient(t, test.ownerObj...)
+			}
+
+			namespace := test.namespace
+			if namespace == "" {
+				namespace = velerov1.DefaultNamespace
+			}
+
+			owners, err := exposer.AdoptOrphanedBackupPods(context.Background(), namespace, "fake-node")
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedOwners, owners)
+
+			for _, owner := range owners {
+				assert.Equal(t, namespace, exposer.resolveExposeNamespace(context.Background(), owner))
+			}
+		})
+	}
+}
+
+func TestExposeFromSnapshotHandle(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		snapshotHandle   string
+		driver           string
+		vsClass          string
+		volumeSize       resource.Quantity
+		snapshotReactors []reactor
+		err              string
+		expectedErrKind  error
+	}{
+		{
+			name:            "missing snapshot handle",
+			driver:          "fake-driver",
+			volumeSize:      resource.MustParse("1Gi"),
+			err:             "snapshot handle and driver are required",
+			expectedErrKind: ErrInvalidExposeParam,
+		},
+		{
+			name:            "missing volume size",
+			snapshotHandle:  "fake-handle",
+			driver:          "fake-driver",
+			err:             "volume size is required to expose snapshot handle",
+			expectedErrKind: ErrInvalidExposeParam,
+		},
+		{
+			name:           "create backup vs fail",
+			snapshotHandle: "fake-handle",
+			driver:         "fake-driver",
+			vsClass:        "fake-snapshot-class",
+			volumeSize:     resource.MustParse("1Gi"),
+			snapshotReactors: []reactor{
+				{
+					verb:     "create",
+					resource: "volumesnapshots",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-create-error")
+					},
 				},
 			},
+			err:             "error to create backup volume snapshot: fake-create-error",
+			expectedErrKind: ErrBackupSnapshotCreateFailed,
+		},
+		{
+			name:           "succeed",
+			snapshotHandle: "fake-handle",
+			driver:         "fake-driver",
+			vsClass:        "fake-snapshot-class",
+			volumeSize:     resource.MustParse("1Gi"),
+		},
+		{
+			name:           "succeed without class",
+			snapshotHandle: "fake-handle",
+			driver:         "fake-driver",
+			volumeSize:     resource.MustParse("1Gi"),
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset()
+			fakeKubeClient := fake.NewSimpleClientset(daemonSet)
 
-			fakeClientBuilder := clientFake.NewClientBuilder()
-			fakeClientBuilder = fakeClientBuilder.WithScheme(scheme)
+			for _, reactor := range test.snapshotReactors {
+				fakeSnapshotClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
 
-			fakeClient := fakeClientBuilder.WithRuntimeObjects(test.kubeClientObj...).Build()
+			exposer := csiSnapshotExposer{
+				kubeClient:        fakeKubeClient,
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
+			}
+
+			param := &CSISnapshotExposeParam{
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				VolumeSize:       test.volumeSize,
+			}
+
+			err := exposer.ExposeFromSnapshotHandle(context.Background(), ownerObject, test.snapshotHandle, test.driver, test.vsClass, param)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, test.expectedErrKind)
+
+				_, err = fakeSnapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				assert.True(t, apierrors.IsNotFound(err))
+				return
+			}
+
+			require.NoError(t, err)
+
+			backupVSC, err := fakeSnapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.snapshotHandle, *backupVSC.Spec.Source.SnapshotHandle)
+			assert.Equal(t, test.driver, backupVSC.Spec.Driver)
+			assert.Equal(t, snapshotv1api.VolumeSnapshotContentRetain, backupVSC.Spec.DeletionPolicy)
+			assert.Equal(t, ownerObject.Name, backupVSC.Spec.VolumeSnapshotRef.Name)
+			assert.Equal(t, ownerObject.Namespace, backupVSC.Spec.VolumeSnapshotRef.Namespace)
+
+			backupVS, err := fakeSnapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, backupVSC.Name, *backupVS.Spec.Source.VolumeSnapshotContentName)
+
+			if test.vsClass == "" {
+				assert.Nil(t, backupVSC.Spec.VolumeSnapshotClassName)
+				assert.Nil(t, backupVS.Spec.VolumeSnapshotClassName)
+			} else {
+				assert.Equal(t, test.vsClass, *backupVSC.Spec.VolumeSnapshotClassName)
+				assert.Equal(t, test.vsClass, *backupVS.Spec.VolumeSnapshotClassName)
+			}
+
+			backupPVC, err := fakeKubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, backupVS.Name, backupPVC.Spec.DataSource.Name)
+			assert.Equal(t, test.volumeSize, backupPVC.Spec.Resources.Requests[corev1api.ResourceStorage])
+
+			_, err = fakeKubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			exposer.CleanUp(context.Background(), ownerObject, "", "")
+
+			_, err = fakeSnapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			assert.True(t, apierrors.IsNotFound(err))
+		})
+	}
+}
+
+func TestReconcileSourceSnapshotChange(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	currentHandle := "fake-current-handle"
+	staleHandle := "fake-stale-handle"
+
+	sourceVSC := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-vsc",
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			SnapshotHandle: &currentHandle,
+		},
+	}
+
+	backupVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	otherOwnerVS := backupVS.DeepCopy()
+	otherOwnerVS.Labels = map[string]string{exposeOwnerUIDLabel: "other-uid"}
+
+	backupVSC := func(handle string, ownerUID string) *snapshotv1api.VolumeSnapshotContent {
+		return &snapshotv1api.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   ownerObject.Name,
+				Labels: map[string]string{exposeOwnerUIDLabel: ownerUID},
+			},
+			Spec: snapshotv1api.VolumeSnapshotContentSpec{
+				Source: snapshotv1api.VolumeSnapshotContentSource{
+					SnapshotHandle: &handle,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		snapshotClientObj []runtime.Object
+		rebuild           bool
+		err               string
+		expectedErrKind   error
+		expectedDeleted   bool
+	}{
+		{
+			name: "no backup VSC",
+		},
+		{
+			name:              "matching source handle",
+			snapshotClientObj: []runtime.Object{backupVS, backupVSC(currentHandle, "fake-uid")},
+			rebuild:           true,
+		},
+		{
+			name:              "changed source handle without rebuild",
+			snapshotClientObj: []runtime.Object{backupVS, backupVSC(staleHandle, "fake-uid")},
+			err:               "existing backup VSC fake-backup refers to snapshot handle fake-stale-handle, which differs from handle fake-current-handle of the source snapshot",
+			expectedErrKind:   ErrExposeConflict,
+		},
+		{
+			name:              "changed source handle with rebuild",
+			snapshotClientObj: []runtime.Object{backupVS, backupVSC(staleHandle, "fake-uid")},
+			rebuild:           true,
+			expectedDeleted:   true,
+		},
+		{
+			name:              "changed source handle with rebuild, backup VS doesn't exist",
+			snapshotClientObj: []runtime.Object{backupVSC(staleHandle, "fake-uid")},
+			rebuild:           true,
+			expectedDeleted:   true,
+		},
+		{
+			name:              "changed source handle with rebuild, backup VSC of another owner",
+			snapshotClientObj: []runtime.Object{backupVS, backupVSC(staleHandle, "other-uid")},
+			rebuild:           true,
+			err:               "stale backup VSC fake-backup belongs to another owner, delete it to retry",
+			expectedErrKind:   ErrExposeConflict,
+		},
+		{
+			name:              "changed source handle with rebuild, backup VS of another owner",
+			snapshotClientObj: []runtime.Object{otherOwnerVS, backupVSC(staleHandle, "fake-uid")},
+			rebuild:           true,
+			err:               "stale backup VS velero/fake-backup belongs to another owner, delete it to retry",
+			expectedErrKind:   ErrExposeConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeSnapshotClient := snapshotFake.NewSimpleClientset(test.snapshotClientObj...)
 
 			exposer := csiSnapshotExposer{
-				kubeClient: fakeKubeClient,
-				log:        velerotest.NewLogger(),
+				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
+				log:               velerotest.NewLogger(),
 			}
 
-			var ownerObject corev1api.ObjectReference
-			if test.ownerBackup != nil {
-				ownerObject = corev1api.ObjectReference{
-					Kind:       test.ownerBackup.Kind,
-					Namespace:  test.ownerBackup.Namespace,
-					Name:       test.ownerBackup.Name,
-					UID:        test.ownerBackup.UID,
-					APIVersion: test.ownerBackup.APIVersion,
+			err := exposer.reconcileSourceSnapshotChange(context.Background(), ownerObject, ownerObject.Namespace, sourceVSC, test.rebuild, time.Second, velerotest.NewLogger())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				assert.ErrorIs(t, err, test.expectedErrKind)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if len(test.snapshotClientObj) == 0 {
+				return
+			}
+
+			_, err = fakeSnapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			assert.Equal(t, test.expectedDeleted, apierrors.IsNotFound(err))
+
+			_, err = fakeSnapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			if test.expectedDeleted {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+		})
+	}
+}
+
+func TestCleanUpStaticBackupPV(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: "velero",
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+			UID:       "fake-pvc-uid",
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv",
+		},
+	}
+
+	backupPV := func(annotations map[string]string, claimUID types.UID) *corev1api.PersistentVolume {
+		return &corev1api.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "fake-pv",
+				Annotations: annotations,
+			},
+			Spec: corev1api.PersistentVolumeSpec{
+				PersistentVolumeReclaimPolicy: corev1api.PersistentVolumeReclaimRetain,
+				ClaimRef: &corev1api.ObjectReference{
+					Namespace: backupPVC.Namespace,
+					Name:      backupPVC.Name,
+					UID:       claimUID,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		pv                *corev1api.PersistentVolume
+		expectedPVPatched bool
+		expectedPVDeleted bool
+	}{
+		{
+			name:              "dynamic backup PV is reclaimed",
+			pv:                backupPV(map[string]string{kube.KubeAnnDynamicallyProvisioned: "fake-driver"}, backupPVC.UID),
+			expectedPVPatched: true,
+			expectedPVDeleted: true,
+		},
+		{
+			name:              "static backup PV is deleted without reclaim",
+			pv:                backupPV(map[string]string{staticBackupPVAnnotation: string(ownerObject.UID)}, ""),
+			expectedPVDeleted: true,
+		},
+		{
+			name: "PV not provisioned for backup PVC is kept",
+			pv:   backupPV(nil, backupPVC.UID),
+		},
+		{
+			name: "PV claimed by another PVC is kept",
+			pv:   backupPV(map[string]string{kube.KubeAnnDynamicallyProvisioned: "fake-driver"}, "other-pvc-uid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(backupPVC, test.pv)
+
+			pvPatched := false
+			fakeKubeClient.Fake.PrependReactor("patch", "persistentvolumes", func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+				pvPatched = true
+				return false, nil, nil
+			})
+
+			// simulate the PV controller reclaiming the backup PV patched to Delete once the backupPVC is deleted
+			fakeKubeClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+				if pvPatched {
+					require.NoError(t, fakeKubeClient.Tracker().Delete(corev1api.SchemeGroupVersion.WithResource("persistentvolumes"), "", "fake-pv"))
 				}
+				return false, nil, nil
+			})
+
+			exposer := NewCSISnapshotExposer(fakeKubeClient, snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), WithCleanUpConcurrency(false))
+			exposer.CleanUp(context.Background(), ownerObject, "fake-vs", "fake-ns")
+
+			_, err := fakeKubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			assert.True(t, apierrors.IsNotFound(err))
+
+			assert.Equal(t, test.expectedPVPatched, pvPatched)
+
+			_, err = fakeKubeClient.CoreV1().PersistentVolumes().Get(context.Background(), "fake-pv", metav1.GetOptions{})
+			if test.expectedPVDeleted {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
 			}
+		})
+	}
+}
 
-			test.exposeWaitParam.NodeClient = fakeClient
+func TestExposeTargetNamespace(t *testing.T) {
+	vscName := "fake-vsc"
+	snapshotClass := "fake-snapshot-class"
+	snapshotHandle := "fake-handle"
+	var restoreSize int64 = 123456
 
-			result, err := exposer.GetExposed(context.Background(), ownerObject, test.Timeout, &test.exposeWaitParam)
-			if test.err == "" {
-				require.NoError(t, err)
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &vscName,
+			},
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+			RestoreSize:                    resource.NewQuantity(restoreSize, ""),
+		},
+	}
+
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy:          snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:                  "fake-driver",
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			RestoreSize:    &restoreSize,
+			SnapshotHandle: &snapshotHandle,
+		},
+	}
+
+	vsClassObj := &snapshotv1api.VolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: snapshotClass,
+		},
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	targetNamespace := &corev1api.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "velero-movers",
+		},
+	}
+
+	param := &CSISnapshotExposeParam{
+		SnapshotName:     "fake-vs",
+		SourceNamespace:  "fake-ns",
+		TargetNamespace:  "velero-movers",
+		AccessMode:       AccessModeFileSystem,
+		OperationTimeout: time.Millisecond,
+		ExposeTimeout:    time.Millisecond,
+	}
+
+	t.Run("target namespace doesn't exist", func(t *testing.T) {
+		exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj).SnapshotV1(), velerotest.NewLogger())
+
+		err := exposer.Expose(context.Background(), ownerObject, param)
+		require.EqualError(t, err, "target namespace velero-movers doesn't exist")
+		assert.ErrorIs(t, err, ErrInvalidExposeParam)
+	})
+
+	t.Run("expose into target namespace", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset(daemonSet, targetNamespace)
+		snapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj).SnapshotV1()
+
+		exposer := NewCSISnapshotExposer(kubeClient, snapshotClient, velerotest.NewLogger())
+		require.NoError(t, exposer.Expose(context.Background(), ownerObject, param))
+
+		backupVS, err := snapshotClient.VolumeSnapshots("velero-movers").Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, string(ownerObject.UID), backupVS.Labels[exposeOwnerUIDLabel])
+
+		backupVSC, err := snapshotClient.VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "velero-movers", backupVSC.Spec.VolumeSnapshotRef.Namespace)
+
+		backupPVC, err := kubeClient.CoreV1().PersistentVolumeClaims("velero-movers").Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, backupPVC.OwnerReferences)
+		assert.Equal(t, string(ownerObject.UID), backupPVC.Labels[exposeOwnerUIDLabel])
+
+		backupPod, err := kubeClient.CoreV1().Pods("velero-movers").Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, backupPod.OwnerReferences)
+		assert.Equal(t, string(ownerObject.UID), backupPod.Labels[exposeOwnerUIDLabel])
+		assert.Equal(t, ownerObject.Namespace, backupPod.Labels[exposeOwnerNamespaceLabel])
+
+		_, err = kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+
+		// a new exposer, e.g., after a restart, resolves the target namespace from the backup VSC
+		restarted := NewCSISnapshotExposer(kubeClient, snapshotClient, velerotest.NewLogger()).(*csiSnapshotExposer)
+
+		diag, err := restarted.DiagnoseExposeStructured(context.Background(), ownerObject)
+		require.NoError(t, err)
+		require.NotNil(t, diag.Pod)
+		assert.Equal(t, "velero-movers", diag.Pod.Namespace)
+		assert.Empty(t, diag.PVCError)
+		assert.Empty(t, diag.VSError)
+
+		restarted.CleanUp(context.Background(), ownerObject, "", "")
+
+		_, err = kubeClient.CoreV1().Pods("velero-movers").Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+
+		_, err = kubeClient.CoreV1().PersistentVolumeClaims("velero-movers").Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+
+		_, err = snapshotClient.VolumeSnapshots("velero-movers").Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+}
+
+func TestGetExposeOwnerOf(t *testing.T) {
+	dataUploadRef := corev1api.ObjectReference{
+		APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+		Kind:       "DataUpload",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-du",
+		UID:        "fake-uid",
+	}
+
+	tests := []struct {
+		name          string
+		pod           *corev1api.Pod
+		expectedOwner corev1api.ObjectReference
+		expectedFound bool
+	}{
+		{
+			name: "controller reference",
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: velerov1.DefaultNamespace,
+					Name:      "fake-du",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+							Kind:       "DataUpload",
+							Name:       "fake-du",
+							UID:        "fake-uid",
+							Controller: boolptr.True(),
+						},
+					},
+				},
+			},
+			expectedOwner: dataUploadRef,
+			expectedFound: true,
+		},
+		{
+			name: "cross namespace labels",
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "velero-movers",
+					Name:      "fake-du",
+					Labels:    labels.Merge(getCrossNamespaceOwnerLabels(dataUploadRef, "velero-movers"), getDataUploadLabels(dataUploadRef)),
+				},
+			},
+			expectedOwner: dataUploadRef,
+			expectedFound: true,
+		},
+		{
+			name: "cross namespace labels without DataUpload label",
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "velero-movers",
+					Name:      "fake-du",
+					Labels:    getCrossNamespaceOwnerLabels(dataUploadRef, "velero-movers"),
+				},
+			},
+		},
+		{
+			name: "owner uid label without owner namespace",
+			pod: &corev1api.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "velero-movers",
+					Name:      "fake-du",
+					Labels:    labels.Merge(getExposeOwnerLabels(dataUploadRef), getDataUploadLabels(dataUploadRef)),
+				},
+			},
+		},
+	}
 
-				if test.expectedResult == nil {
-					assert.Nil(t, result)
-				} else {
-					require.NoError(t, err)
-					assert.Equal(t, test.expectedResult.ByPod.VolumeName, result.ByPod.VolumeName)
-					assert.Equal(t, test.expectedResult.ByPod.HostingPod.Name, result.ByPod.HostingPod.Name)
-				}
-			} else {
-				assert.EqualError(t, err, test.err)
-			}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			owner, found := getExposeOwnerOf(test.pod)
+			assert.Equal(t, test.expectedFound, found)
+			assert.Equal(t, test.expectedOwner, owner)
 		})
 	}
 }
 
-func TestPeekExpose(t *testing.T) {
-	backup := &velerov1.Backup{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: velerov1.SchemeGroupVersion.String(),
-			Kind:       "Backup",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			UID:       "fake-uid",
+func TestGetExposedWaitsBackupPodReady(t *testing.T) {
+	backupPodReadyPollInterval = 10 * time.Millisecond
+	defer func() {
+		backupPodReadyPollInterval = time.Second
+	}()
+
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	probe := &corev1api.Probe{
+		ProbeHandler: corev1api.ProbeHandler{
+			Exec: &corev1api.ExecAction{
+				Command: []string{"/velero", "data-mover", "ready"},
+			},
 		},
 	}
 
-	backupPodUrecoverable := &corev1api.Pod{
+	backupPod := func(readinessProbe *corev1api.Probe, phase corev1api.PodPhase, ready corev1api.ConditionStatus) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ownerObject.Namespace,
+				Name:      ownerObject.Name,
+			},
+			Spec: corev1api.PodSpec{
+				Containers: []corev1api.Container{
+					{
+						Name:           string(ownerObject.UID),
+						ReadinessProbe: readinessProbe,
+					},
+				},
+				Volumes: []corev1api.Volume{
+					{
+						Name: string(ownerObject.UID),
+					},
+				},
+			},
+			Status: corev1api.PodStatus{
+				Phase: phase,
+				Conditions: []corev1api.PodCondition{
+					{
+						Type:   corev1api.PodReady,
+						Status: ready,
+					},
+				},
+			},
+		}
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: backup.Namespace,
-			Name:      backup.Name,
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
 		},
-		Status: corev1api.PodStatus{
-			Phase: corev1api.PodFailed,
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv-name",
 		},
 	}
 
-	backupPod := &corev1api.Pod{
+	backupPV := &corev1api.PersistentVolume{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: backup.Namespace,
-			Name:      backup.Name,
+			Name: "fake-pv-name",
 		},
 	}
 
 	scheme := runtime.NewScheme()
-	corev1api.AddToScheme(scheme)
+	require.NoError(t, corev1api.AddToScheme(scheme))
 
 	tests := []struct {
-		name          string
-		kubeClientObj []runtime.Object
-		ownerBackup   *velerov1.Backup
-		err           string
+		name string
+		pod  *corev1api.Pod
+		err  string
 	}{
 		{
-			name:        "backup pod is not found",
-			ownerBackup: backup,
+			name: "no readiness probe",
+			pod:  backupPod(nil, corev1api.PodRunning, corev1api.ConditionFalse),
 		},
 		{
-			name:        "pod is unrecoverable",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				backupPodUrecoverable,
-			},
-			err: "Pod is in abnormal state [Failed], message []",
+			name: "pod is ready",
+			pod:  backupPod(probe, corev1api.PodRunning, corev1api.ConditionTrue),
 		},
 		{
-			name:        "succeed",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				backupPod,
-			},
+			name: "pod is not ready",
+			pod:  backupPod(probe, corev1api.PodRunning, corev1api.ConditionFalse),
+			err:  "error to wait backup pod ready, fake-backup: timeout to wait backup pod fake-backup ready",
+		},
+		{
+			name: "pod terminated",
+			pod:  backupPod(probe, corev1api.PodFailed, corev1api.ConditionFalse),
+			err:  "error to wait backup pod ready, fake-backup: backup pod fake-backup terminated in phase Failed before it is ready",
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+			objects := []runtime.Object{test.pod, backupPVC, backupPV}
 
 			exposer := csiSnapshotExposer{
-				kubeClient: fakeKubeClient,
+				kubeClient: fake.NewSimpleClientset(objects...),
 				log:        velerotest.NewLogger(),
 			}
 
-			var ownerObject corev1api.ObjectReference
-			if test.ownerBackup != nil {
-				ownerObject = corev1api.ObjectReference{
-					Kind:       test.ownerBackup.Kind,
-					Namespace:  test.ownerBackup.Namespace,
-					Name:       test.ownerBackup.Name,
-					UID:        test.ownerBackup.UID,
-					APIVersion: test.ownerBackup.APIVersion,
-				}
-			}
+			result, err := exposer.GetExposed(context.Background(), ownerObject, 100*time.Millisecond, &CSISnapshotExposeWaitParam{
+				NodeClient: clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build(),
+				NodeName:   "fake-node",
+			})
 
-			err := exposer.PeekExposed(context.Background(), ownerObject)
-			if test.err == "" {
-				assert.NoError(t, err)
-			} else {
-				assert.EqualError(t, err, test.err)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
 			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, test.pod.Name, result.ByPod.HostingPod.Name)
 		})
 	}
 }
 
-func Test_csiSnapshotExposer_createBackupPVC(t *testing.T) {
-	backup := &velerov1.Backup{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: velerov1.SchemeGroupVersion.String(),
-			Kind:       "Backup",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			UID:       "fake-uid",
-		},
-	}
-
-	dataSource := &corev1api.TypedLocalObjectReference{
-		APIGroup: &snapshotv1api.SchemeGroupVersion.Group,
-		Kind:     "VolumeSnapshot",
-		Name:     "fake-snapshot",
+func TestBackupPodReadinessProbe(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
 	}
-	volumeMode := corev1api.PersistentVolumeFilesystem
 
-	backupPVC := corev1api.PersistentVolumeClaim{
+	daemonSet := &appsv1api.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
-					Controller: pointer.BoolPtr(true),
-				},
-			},
+			Namespace: "velero",
+			Name:      "node-agent",
 		},
-		Spec: corev1api.PersistentVolumeClaimSpec{
-			AccessModes: []corev1api.PersistentVolumeAccessMode{
-				corev1api.ReadWriteOnce,
-			},
-			VolumeMode:       &volumeMode,
-			DataSource:       dataSource,
-			DataSourceRef:    nil,
-			StorageClassName: pointer.String("fake-storage-class"),
-			Resources: corev1api.VolumeResourceRequirements{
-				Requests: corev1api.ResourceList{
-					corev1api.ResourceStorage: resource.MustParse("1Gi"),
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
 				},
 			},
 		},
 	}
 
-	backupPVCReadOnly := corev1api.PersistentVolumeClaim{
+	backupPVC := &corev1api.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
-					Controller: pointer.BoolPtr(true),
-				},
-			},
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
 		},
-		Spec: corev1api.PersistentVolumeClaimSpec{
-			AccessModes: []corev1api.PersistentVolumeAccessMode{
-				corev1api.ReadOnlyMany,
-			},
-			VolumeMode:       &volumeMode,
-			DataSource:       dataSource,
-			DataSourceRef:    nil,
-			StorageClassName: pointer.String("fake-storage-class"),
-			Resources: corev1api.VolumeResourceRequirements{
-				Requests: corev1api.ResourceList{
-					corev1api.ResourceStorage: resource.MustParse("1Gi"),
-				},
+	}
+
+	exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger()).(*csiSnapshotExposer)
+
+	_, err := exposer.validateExpose(context.Background(), ownerObject, &CSISnapshotExposeParam{
+		OperationTimeout: time.Second,
+		ReadinessProbe:   &corev1api.Probe{PeriodSeconds: 5},
+	}, velerotest.NewLogger())
+	require.EqualError(t, err, "readiness probe of backup pod has no handler")
+	assert.ErrorIs(t, err, ErrInvalidExposeParam)
+
+	pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, &CSISnapshotExposeParam{OperationTimeout: time.Second}, false, false, kube.NodeOSLinux, "", nil)
+	require.NoError(t, err)
+	assert.Nil(t, pod.Spec.Containers[0].ReadinessProbe)
+
+	probe := &corev1api.Probe{
+		ProbeHandler: corev1api.ProbeHandler{
+			Exec: &corev1api.ExecAction{
+				Command: []string{"/velero", "data-mover", "ready"},
 			},
 		},
 	}
 
-	tests := []struct {
-		name              string
-		ownerBackup       *velerov1.Backup
-		backupVS          string
-		storageClass      string
-		accessMode        string
-		resource          resource.Quantity
-		readOnly          bool
-		kubeClientObj     []runtime.Object
-		snapshotClientObj []runtime.Object
-		want              *corev1api.PersistentVolumeClaim
-		wantErr           assert.ErrorAssertionFunc
-	}{
-		{
-			name:         "backupPVC gets created successfully with parameters from source PVC",
-			ownerBackup:  backup,
-			backupVS:     "fake-snapshot",
-			storageClass: "fake-storage-class",
-			accessMode:   AccessModeFileSystem,
-			resource:     resource.MustParse("1Gi"),
-			readOnly:     false,
-			want:         &backupPVC,
-			wantErr:      assert.NoError,
+	require.NoError(t, exposer.kubeClient.CoreV1().Pods(ownerObject.Namespace).Delete(context.Background(), ownerObject.Name, metav1.DeleteOptions{}))
+
+	pod, err = exposer.createBackupPod(context.Background(), ownerObject, backupPVC, &CSISnapshotExposeParam{OperationTimeout: time.Second, ReadinessProbe: probe}, false, false, kube.NodeOSLinux, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, probe, pod.Spec.Containers[0].ReadinessProbe)
+}
+
+func TestExposeIdempotent(t *testing.T) {
+	vscName := "fake-vsc"
+	snapshotClass := "fake-snapshot-class"
+	snapshotHandle := "fake-handle"
+	var restoreSize int64 = 123456
+
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
 		},
-		{
-			name:         "backupPVC gets created successfully with parameters from source PVC but accessMode from backupPVC Config as read only",
-			ownerBackup:  backup,
-			backupVS:     "fake-snapshot",
-			storageClass: "fake-storage-class",
-			accessMode:   AccessModeFileSystem,
-			resource:     resource.MustParse("1Gi"),
-			readOnly:     true,
-			want:         &backupPVCReadOnly,
-			wantErr:      assert.NoError,
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &vscName,
+			},
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+			RestoreSize:                    resource.NewQuantity(restoreSize, ""),
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			fakeKubeClient := fake.NewSimpleClientset(tt.kubeClientObj...)
-			fakeSnapshotClient := snapshotFake.NewSimpleClientset(tt.snapshotClientObj...)
-			e := &csiSnapshotExposer{
-				kubeClient:        fakeKubeClient,
-				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
-				log:               velerotest.NewLogger(),
-			}
-			var ownerObject corev1api.ObjectReference
-			if tt.ownerBackup != nil {
-				ownerObject = corev1api.ObjectReference{
-					Kind:       tt.ownerBackup.Kind,
-					Namespace:  tt.ownerBackup.Namespace,
-					Name:       tt.ownerBackup.Name,
-					UID:        tt.ownerBackup.UID,
-					APIVersion: tt.ownerBackup.APIVersion,
-				}
-			}
-			got, err := e.createBackupPVC(context.Background(), ownerObject, tt.backupVS, tt.storageClass, tt.accessMode, tt.resource, tt.readOnly)
-			if !tt.wantErr(t, err, fmt.Sprintf("createBackupPVC(%v, %v, %v, %v, %v, %v)", ownerObject, tt.backupVS, tt.storageClass, tt.accessMode, tt.resource, tt.readOnly)) {
-				return
-			}
-			assert.Equalf(t, tt.want, got, "createBackupPVC(%v, %v, %v, %v, %v, %v)", ownerObject, tt.backupVS, tt.storageClass, tt.accessMode, tt.resource, tt.readOnly)
-		})
-	}
-}
 
-func Test_csiSnapshotExposer_DiagnoseExpose(t *testing.T) {
-	backup := &velerov1.Backup{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: velerov1.SchemeGroupVersion.String(),
-			Kind:       "Backup",
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy:          snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:                  "fake-driver",
+			VolumeSnapshotClassName: &snapshotClass,
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			RestoreSize:    &restoreSize,
+			SnapshotHandle: &snapshotHandle,
 		},
+	}
+
+	vsClassObj := &snapshotv1api.VolumeSnapshotClass{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			UID:       "fake-uid",
+			Name: snapshotClass,
 		},
 	}
 
-	backupPodWithoutNodeName := corev1api.Pod{
+	daemonSet := &appsv1api.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
-				},
-			},
+			Namespace: "velero",
+			Name:      "node-agent",
 		},
-		Status: corev1api.PodStatus{
-			Phase: corev1api.PodPending,
-			Conditions: []corev1api.PodCondition{
-				{
-					Type:    corev1api.PodInitialized,
-					Status:  corev1api.ConditionTrue,
-					Message: "fake-pod-message",
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
 				},
 			},
 		},
 	}
 
-	backupPodWithNodeName := corev1api.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
-				},
+	param := &CSISnapshotExposeParam{
+		SnapshotName:     "fake-vs",
+		SourceNamespace:  "fake-ns",
+		AccessMode:       AccessModeFileSystem,
+		OperationTimeout: time.Millisecond,
+		ExposeTimeout:    time.Millisecond,
+	}
+
+	isCreateOrDelete := func(action clientTesting.Action) bool {
+		return action.GetVerb() == "create" || action.GetVerb() == "delete"
+	}
+
+	assertSingleExpose := func(t *testing.T, kubeClient *fake.Clientset, snapshotClient *snapshotFake.Clientset) {
+		t.Helper()
+
+		vsList, err := snapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, vsList.Items, 1)
+		assert.Equal(t, ownerObject.Name, vsList.Items[0].Name)
+
+		backupVSC, err := snapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, ownerObject.Name, backupVSC.Spec.VolumeSnapshotRef.Name)
+
+		pvcList, err := kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, pvcList.Items, 1)
+		assert.Equal(t, ownerObject.Name, pvcList.Items[0].Name)
+
+		podList, err := kubeClient.CoreV1().Pods(ownerObject.Namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, podList.Items, 1)
+		assert.Equal(t, ownerObject.Name, podList.Items[0].Name)
+	}
+
+	t.Run("expose twice back-to-back", func(t *testing.T) {
+		kubeClient := fake.NewSimpleClientset(daemonSet)
+		snapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj)
+
+		exposer := NewCSISnapshotExposer(kubeClient, snapshotClient.SnapshotV1(), velerotest.NewLogger())
+		require.NoError(t, exposer.Expose(context.Background(), ownerObject, param))
+
+		kubeClient.ClearActions()
+		snapshotClient.ClearActions()
+
+		require.NoError(t, exposer.Expose(context.Background(), ownerObject, param))
+
+		for _, action := range append(kubeClient.Actions(), snapshotClient.Actions()...) {
+			assert.False(t, isCreateOrDelete(action), "unexpected %s of %s by the second expose", action.GetVerb(), action.GetResource().Resource)
+		}
+
+		assertSingleExpose(t, kubeClient, snapshotClient)
+	})
+
+	t.Run("objects created by a concurrent expose are adopted and kept on failure", func(t *testing.T) {
+		backupVSCName := ownerObject.Name
+		backupVS := &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ownerObject.Namespace,
+				Name:      ownerObject.Name,
+				Labels:    map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)},
 			},
-		},
-		Spec: corev1api.PodSpec{
-			NodeName: "fake-node",
-		},
-		Status: corev1api.PodStatus{
-			Phase: corev1api.PodPending,
-			Conditions: []corev1api.PodCondition{
-				{
-					Type:    corev1api.PodInitialized,
-					Status:  corev1api.ConditionTrue,
-					Message: "fake-pod-message",
+			Spec: snapshotv1api.VolumeSnapshotSpec{
+				Source: snapshotv1api.VolumeSnapshotSource{
+					VolumeSnapshotContentName: &backupVSCName,
 				},
 			},
-		},
-	}
+		}
 
-	backupPVCWithoutVolumeName := corev1api.PersistentVolumeClaim{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
+		backupVSC := &snapshotv1api.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   ownerObject.Name,
+				Labels: map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)},
+			},
+			Spec: snapshotv1api.VolumeSnapshotContentSpec{
+				VolumeSnapshotRef: corev1api.ObjectReference{
+					Namespace: ownerObject.Namespace,
+					Name:      ownerObject.Name,
+				},
+				Source: snapshotv1api.VolumeSnapshotContentSource{
+					SnapshotHandle: &snapshotHandle,
 				},
 			},
-		},
-		Status: corev1api.PersistentVolumeClaimStatus{
-			Phase: corev1api.ClaimPending,
-		},
+		}
+
+		backupPVC := &corev1api.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       ownerObject.Namespace,
+				Name:            ownerObject.Name,
+				OwnerReferences: getExposeOwnerReferences(ownerObject, ownerObject.Namespace),
+			},
+		}
+
+		kubeClient := fake.NewSimpleClientset(daemonSet, backupPVC)
+		kubeClient.Fake.PrependReactor("create", "pods", func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+			return true, nil, errors.New("fake-create-error")
+		})
+
+		snapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj, backupVS, backupVSC)
+
+		exposer := NewCSISnapshotExposer(kubeClient, snapshotClient.SnapshotV1(), velerotest.NewLogger())
+		require.ErrorContains(t, exposer.Expose(context.Background(), ownerObject, param), "fake-create-error")
+
+		_, err := snapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		_, err = snapshotClient.SnapshotV1().VolumeSnapshotContents().Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		_, err = kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+}
+
+func TestExposeReuseBackupPVC(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
 	}
 
-	backupPVCWithVolumeName := corev1api.PersistentVolumeClaim{
+	backupVSCName := ownerObject.Name
+	snapshotClass := "fake-snapshot-class"
+	backupVS := &snapshotv1api.VolumeSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
-				},
-			},
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+			Labels:    map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)},
 		},
-		Spec: corev1api.PersistentVolumeClaimSpec{
-			VolumeName: "fake-pv",
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &backupVSCName,
+			},
+			VolumeSnapshotClassName: &snapshotClass,
 		},
-		Status: corev1api.PersistentVolumeClaimStatus{
-			Phase: corev1api.ClaimPending,
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &backupVSCName,
+			RestoreSize:                    resource.NewQuantity(123456, ""),
 		},
 	}
 
-	backupPV := corev1api.PersistentVolume{
+	backupVSC := &snapshotv1api.VolumeSnapshotContent{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "fake-pv",
+			Name:   backupVSCName,
+			Labels: map[string]string{exposeOwnerUIDLabel: string(ownerObject.UID)},
 		},
-		Status: corev1api.PersistentVolumeStatus{
-			Phase:   corev1api.VolumePending,
-			Message: "fake-pv-message",
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			Driver: "fake-driver",
 		},
 	}
 
-	readyToUse := false
-	vscMessage := "fake-vsc-message"
-	backupVSC := snapshotv1api.VolumeSnapshotContent{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "fake-vsc",
-		},
-		Status: &snapshotv1api.VolumeSnapshotContentStatus{
-			ReadyToUse: &readyToUse,
-			Error: &snapshotv1api.VolumeSnapshotError{
-				Message: &vscMessage,
+	backupPVC := func(dataSourceName string, phase corev1api.PersistentVolumeClaimPhase) *corev1api.PersistentVolumeClaim {
+		return &corev1api.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       ownerObject.Namespace,
+				Name:            ownerObject.Name,
+				OwnerReferences: getExposeOwnerReferences(ownerObject, ownerObject.Namespace),
 			},
-		},
+			Spec: corev1api.PersistentVolumeClaimSpec{
+				DataSource: &corev1api.TypedLocalObjectReference{
+					APIGroup: &snapshotv1api.SchemeGroupVersion.Group,
+					Kind:     "VolumeSnapshot",
+					Name:     dataSourceName,
+				},
+			},
+			Status: corev1api.PersistentVolumeClaimStatus{
+				Phase: phase,
+			},
+		}
 	}
 
-	backupVSWithoutStatus := snapshotv1api.VolumeSnapshot{
+	daemonSet := &appsv1api.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
 				},
 			},
 		},
 	}
 
-	backupVSWithoutVSC := snapshotv1api.VolumeSnapshot{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
-				},
+	leftoverPod := func(phase corev1api.PodPhase) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       ownerObject.Namespace,
+				Name:            ownerObject.Name,
+				OwnerReferences: getExposeOwnerReferences(ownerObject, ownerObject.Namespace),
+			},
+			Status: corev1api.PodStatus{
+				Phase: phase,
 			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		reuse          bool
+		backupPVC      *corev1api.PersistentVolumeClaim
+		leftoverPod    *corev1api.Pod
+		invalidParam   bool
+		expectedReused bool
+	}{
+		{
+			name:           "bound backup pvc is reused",
+			reuse:          true,
+			backupPVC:      backupPVC(ownerObject.Name, corev1api.ClaimBound),
+			expectedReused: true,
+		},
+		{
+			name:           "failed leftover backup pod is replaced",
+			reuse:          true,
+			backupPVC:      backupPVC(ownerObject.Name, corev1api.ClaimBound),
+			leftoverPod:    leftoverPod(corev1api.PodFailed),
+			expectedReused: true,
+		},
+		{
+			name:           "succeeded leftover backup pod is replaced",
+			reuse:          true,
+			backupPVC:      backupPVC(ownerObject.Name, corev1api.ClaimBound),
+			leftoverPod:    leftoverPod(corev1api.PodSucceeded),
+			expectedReused: true,
+		},
+		{
+			name:         "failed leftover backup pod is kept with invalid params",
+			reuse:        true,
+			backupPVC:    backupPVC(ownerObject.Name, corev1api.ClaimBound),
+			leftoverPod:  leftoverPod(corev1api.PodFailed),
+			invalidParam: true,
+		},
+		{
+			name:      "backup pvc is not reused without the option",
+			backupPVC: backupPVC(ownerObject.Name, corev1api.ClaimBound),
+		},
+		{
+			name:      "pending backup pvc is not reused",
+			reuse:     true,
+			backupPVC: backupPVC(ownerObject.Name, corev1api.ClaimPending),
+		},
+		{
+			name:      "backup pvc from another snapshot is not reused",
+			reuse:     true,
+			backupPVC: backupPVC("other-vs", corev1api.ClaimBound),
 		},
-		Status: &snapshotv1api.VolumeSnapshotStatus{},
 	}
 
-	vsMessage := "fake-vs-message"
-	backupVSWithVSC := snapshotv1api.VolumeSnapshot{
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeObjs := []runtime.Object{daemonSet, test.backupPVC}
+			if test.leftoverPod != nil {
+				kubeObjs = append(kubeObjs, test.leftoverPod)
+			}
+
+			kubeClient := fake.NewSimpleClientset(kubeObjs...)
+			snapshotClient := snapshotFake.NewSimpleClientset(backupVS, backupVSC)
+
+			param := &CSISnapshotExposeParam{
+				SnapshotName:              "fake-vs",
+				SourceNamespace:           "fake-ns",
+				AccessMode:                AccessModeFileSystem,
+				OperationTimeout:          time.Millisecond,
+				ExposeTimeout:             time.Millisecond,
+				ReuseBackupPVC:            test.reuse,
+				InjectSnapshotMetadataEnv: true,
+			}
+
+			if test.invalidParam {
+				param.PodRestartPolicy = "fake-policy"
+			}
+
+			exposer := NewCSISnapshotExposer(kubeClient, snapshotClient.SnapshotV1(), velerotest.NewLogger())
+			err := exposer.Expose(context.Background(), ownerObject, param)
+
+			if test.invalidParam {
+				require.ErrorIs(t, err, ErrInvalidExposeParam)
+
+				// nothing is deleted before the params are accepted
+				pod, err := kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, test.leftoverPod.Status.Phase, pod.Status.Phase)
+				return
+			}
+
+			if !test.expectedReused {
+				// the source snapshot doesn't exist, so the expose fails if the backup pvc is not reused
+				require.ErrorIs(t, err, ErrSnapshotNotReady)
+				return
+			}
+
+			require.NoError(t, err)
+
+			for _, action := range snapshotClient.Actions() {
+				assert.NotEqual(t, "create", action.GetVerb())
+			}
+
+			pod, err := kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, pod.Status.Phase)
+			require.NotEmpty(t, pod.Spec.Volumes)
+			assert.Equal(t, ownerObject.Name, pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+			assert.Contains(t, pod.Spec.Containers[0].Env, corev1api.EnvVar{Name: EnvSnapshotDriver, Value: "fake-driver"})
+			assert.Contains(t, pod.Spec.Containers[0].Env, corev1api.EnvVar{Name: EnvSnapshotClass, Value: snapshotClass})
+			assert.Contains(t, pod.Spec.Containers[0].Env, corev1api.EnvVar{Name: EnvRestoreSize, Value: "123456"})
+		})
+	}
+}
+
+func TestExposeDataUploadLabel(t *testing.T) {
+	vscName := "fake-vsc"
+	snapshotClass := "fake-snapshot-class"
+	snapshotHandle := "fake-handle"
+	var restoreSize int64 = 123456
+
+	vsObject := &snapshotv1api.VolumeSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "fake-backup",
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: backup.APIVersion,
-					Kind:       backup.Kind,
-					Name:       backup.Name,
-					UID:        backup.UID,
-				},
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &vscName,
 			},
+			VolumeSnapshotClassName: &snapshotClass,
 		},
 		Status: &snapshotv1api.VolumeSnapshotStatus{
-			BoundVolumeSnapshotContentName: &backupVSC.Name,
-			Error: &snapshotv1api.VolumeSnapshotError{
-				Message: &vsMessage,
-			},
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+			RestoreSize:                    resource.NewQuantity(restoreSize, ""),
 		},
 	}
 
-	nodeAgentPod := corev1api.Pod{
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: velerov1.DefaultNamespace,
-			Name:      "node-agent-pod-1",
-			Labels:    map[string]string{"role": "node-agent"},
+			Name: vscName,
 		},
-		Spec: corev1api.PodSpec{
-			NodeName: "fake-node",
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy:          snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:                  "fake-driver",
+			VolumeSnapshotClassName: &snapshotClass,
 		},
-		Status: corev1api.PodStatus{
-			Phase: corev1api.PodRunning,
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			RestoreSize:    &restoreSize,
+			SnapshotHandle: &snapshotHandle,
 		},
 	}
 
-	tests := []struct {
-		name              string
-		ownerBackup       *velerov1.Backup
-		kubeClientObj     []runtime.Object
-		snapshotClientObj []runtime.Object
-		expected          string
-	}{
-		{
-			name:        "no pod, pvc, vs",
-			ownerBackup: backup,
-			expected: `begin diagnose CSI exposer
-error getting backup pod fake-backup, err: pods "fake-backup" not found
-error getting backup pvc fake-backup, err: persistentvolumeclaims "fake-backup" not found
-error getting backup vs fake-backup, err: volumesnapshots.snapshot.storage.k8s.io "fake-backup" not found
-end diagnose CSI exposer`,
+	vsClassObj := &snapshotv1api.VolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: snapshotClass,
 		},
-		{
-			name:        "pod without node name, pvc without volume name, vs without status",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithoutNodeName,
-				&backupPVCWithoutVolumeName,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithoutStatus,
-			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name 
-Pod condition Initialized, status True, reason , message fake-pod-message
-PVC velero/fake-backup, phase Pending, binding to 
-VS velero/fake-backup, bind to , readyToUse false, errMessage 
-end diagnose CSI exposer`,
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
 		},
-		{
-			name:        "pod without node name, pvc without volume name, vs without VSC",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithoutNodeName,
-				&backupPVCWithoutVolumeName,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithoutVSC,
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
 			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name 
-Pod condition Initialized, status True, reason , message fake-pod-message
-PVC velero/fake-backup, phase Pending, binding to 
-VS velero/fake-backup, bind to , readyToUse false, errMessage 
-end diagnose CSI exposer`,
 		},
+	}
+
+	tests := []struct {
+		name          string
+		kind          string
+		expectedLabel bool
+	}{
 		{
-			name:        "pod with node name, no node agent",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithNodeName,
-				&backupPVCWithoutVolumeName,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithoutVSC,
-			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name fake-node
-Pod condition Initialized, status True, reason , message fake-pod-message
-node-agent is not running in node fake-node, err: daemonset pod not found in running state in node fake-node
-PVC velero/fake-backup, phase Pending, binding to 
-VS velero/fake-backup, bind to , readyToUse false, errMessage 
-end diagnose CSI exposer`,
+			name:          "DataUpload owner",
+			kind:          "DataUpload",
+			expectedLabel: true,
 		},
 		{
-			name:        "pod with node name, node agent is running",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithNodeName,
-				&backupPVCWithoutVolumeName,
-				&nodeAgentPod,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithoutVSC,
-			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name fake-node
-Pod condition Initialized, status True, reason , message fake-pod-message
-PVC velero/fake-backup, phase Pending, binding to 
-VS velero/fake-backup, bind to , readyToUse false, errMessage 
-end diagnose CSI exposer`,
+			name: "other owner",
+			kind: "Backup",
 		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ownerObject := corev1api.ObjectReference{
+				Kind:       test.kind,
+				Namespace:  velerov1.DefaultNamespace,
+				Name:       "fake-du",
+				UID:        "fake-uid",
+				APIVersion: velerov1.SchemeGroupVersion.String(),
+			}
+
+			kubeClient := fake.NewSimpleClientset(daemonSet)
+			snapshotClient := snapshotFake.NewSimpleClientset(vsObject, vscObj, vsClassObj)
+
+			param := &CSISnapshotExposeParam{
+				SnapshotName:     "fake-vs",
+				SourceNamespace:  "fake-ns",
+				AccessMode:       AccessModeFileSystem,
+				OperationTimeout: time.Millisecond,
+				ExposeTimeout:    time.Millisecond,
+			}
+
+			exposer := NewCSISnapshotExposer(kubeClient, snapshotClient.SnapshotV1(), velerotest.NewLogger())
+			require.NoError(t, exposer.Expose(context.Background(), ownerObject, param))
+
+			listOptions := metav1.ListOptions{LabelSelector: DataUploadSelector("fake-du").String()}
+			expectedCount := 0
+			if test.expectedLabel {
+				expectedCount = 1
+			}
+
+			vsList, err := snapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).List(context.Background(), listOptions)
+			require.NoError(t, err)
+			assert.Len(t, vsList.Items, expectedCount)
+
+			pvcList, err := kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).List(context.Background(), listOptions)
+			require.NoError(t, err)
+			assert.Len(t, pvcList.Items, expectedCount)
+
+			podList, err := kubeClient.CoreV1().Pods(ownerObject.Namespace).List(context.Background(), listOptions)
+			require.NoError(t, err)
+			assert.Len(t, podList.Items, expectedCount)
+
+			// the owner label of the backup VS is kept along with the DataUpload label
+			backupVS, err := snapshotClient.SnapshotV1().VolumeSnapshots(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, string(ownerObject.UID), backupVS.Labels[exposeOwnerUIDLabel])
+		})
+	}
+}
+
+func TestIsVolumeAttributesClassSupported(t *testing.T) {
+	vacResources := []*metav1.APIResourceList{
 		{
-			name:        "pvc with volume name, no pv",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithNodeName,
-				&backupPVCWithVolumeName,
-				&nodeAgentPod,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithoutVSC,
+			GroupVersion: "storage.k8s.io/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "volumeattributesclasses", Kind: "VolumeAttributesClass"},
 			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name fake-node
-Pod condition Initialized, status True, reason , message fake-pod-message
-PVC velero/fake-backup, phase Pending, binding to fake-pv
-error getting backup pv fake-pv, err: persistentvolumes "fake-pv" not found
-VS velero/fake-backup, bind to , readyToUse false, errMessage 
-end diagnose CSI exposer`,
 		},
+	}
+
+	tests := []struct {
+		name              string
+		resources         []*metav1.APIResourceList
+		discoveryErr      error
+		expected          bool
+		expectedDiscovery int
+	}{
 		{
-			name:        "pvc with volume name, pv exists",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithNodeName,
-				&backupPVCWithVolumeName,
-				&backupPV,
-				&nodeAgentPod,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithoutVSC,
-			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name fake-node
-Pod condition Initialized, status True, reason , message fake-pod-message
-PVC velero/fake-backup, phase Pending, binding to fake-pv
-PV fake-pv, phase Pending, reason , message fake-pv-message
-VS velero/fake-backup, bind to , readyToUse false, errMessage 
-end diagnose CSI exposer`,
+			name:              "supported is discovered once",
+			resources:         vacResources,
+			expected:          true,
+			expectedDiscovery: 2,
 		},
 		{
-			name:        "vs with vsc, vsc doesn't exist",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithNodeName,
-				&backupPVCWithVolumeName,
-				&backupPV,
-				&nodeAgentPod,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithVSC,
-			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name fake-node
-Pod condition Initialized, status True, reason , message fake-pod-message
-PVC velero/fake-backup, phase Pending, binding to fake-pv
-PV fake-pv, phase Pending, reason , message fake-pv-message
-VS velero/fake-backup, bind to fake-vsc, readyToUse false, errMessage fake-vs-message
-error getting backup vsc fake-vsc, err: volumesnapshotcontents.snapshot.storage.k8s.io "fake-vsc" not found
-end diagnose CSI exposer`,
+			name:              "not supported is discovered once",
+			expectedDiscovery: 3,
 		},
 		{
-			name:        "vs with vsc, vsc exists",
-			ownerBackup: backup,
-			kubeClientObj: []runtime.Object{
-				&backupPodWithNodeName,
-				&backupPVCWithVolumeName,
-				&backupPV,
-				&nodeAgentPod,
-			},
-			snapshotClientObj: []runtime.Object{
-				&backupVSWithVSC,
-				&backupVSC,
-			},
-			expected: `begin diagnose CSI exposer
-Pod velero/fake-backup, phase Pending, node name fake-node
-Pod condition Initialized, status True, reason , message fake-pod-message
-PVC velero/fake-backup, phase Pending, binding to fake-pv
-PV fake-pv, phase Pending, reason , message fake-pv-message
-VS velero/fake-backup, bind to fake-vsc, readyToUse false, errMessage fake-vs-message
-VSC fake-vsc, readyToUse false, errMessage fake-vsc-message, handle 
-end diagnose CSI exposer`,
+			name:              "discovery failure is discovered again",
+			resources:         vacResources,
+			discoveryErr:      errors.New("fake-discovery-error"),
+			expectedDiscovery: 6,
 		},
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			fakeKubeClient := fake.NewSimpleClientset(tt.kubeClientObj...)
-			fakeSnapshotClient := snapshotFake.NewSimpleClientset(tt.snapshotClientObj...)
-			e := &csiSnapshotExposer{
-				kubeClient:        fakeKubeClient,
-				csiSnapshotClient: fakeSnapshotClient.SnapshotV1(),
-				log:               velerotest.NewLogger(),
-			}
-			var ownerObject corev1api.ObjectReference
-			if tt.ownerBackup != nil {
-				ownerObject = corev1api.ObjectReference{
-					Kind:       tt.ownerBackup.Kind,
-					Namespace:  tt.ownerBackup.Namespace,
-					Name:       tt.ownerBackup.Name,
-					UID:        tt.ownerBackup.UID,
-					APIVersion: tt.ownerBackup.APIVersion,
-				}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			kubeClient.Resources = test.resources
+
+			discovery := 0
+			kubeClient.PrependReactor("get", "resource", func(action clientTesting.Action) (bool, runtime.Object, error) {
+				discovery++
+				return test.discoveryErr != nil, nil, test.discoveryErr
+			})
+
+			exposer := &csiSnapshotExposer{
+				kubeClient: kubeClient,
+				log:        velerotest.NewLogger(),
 			}
 
-			diag := e.DiagnoseExpose(context.Background(), ownerObject)
-			assert.Equal(t, tt.expected, diag)
+			assert.Equal(t, test.expected, exposer.isVolumeAttributesClassSupported(exposer.log))
+			assert.Equal(t, test.expected, exposer.isVolumeAttributesClassSupported(exposer.log))
+			assert.Equal(t, test.expectedDiscovery, discovery)
 		})
 	}
 }
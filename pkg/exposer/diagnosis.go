@@ -0,0 +1,233 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"fmt"
+	"strings"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// ExposeDiagnosis is the structured diagnostic info of a snapshot expose.
+// The *Error fields record the failures met when retrieving the corresponding objects.
+type ExposeDiagnosis struct {
+	Pod              *PodDiagnosis      `json:"pod,omitempty"`
+	PodError         string             `json:"podError,omitempty"`
+	NodeAgentRunning bool               `json:"nodeAgentRunning"`
+	NodeAgentError   string             `json:"nodeAgentError,omitempty"`
+	PodLogs          string             `json:"podLogs,omitempty"`
+	PodLogsError     string             `json:"podLogsError,omitempty"`
+	PVC              *PVCDiagnosis      `json:"pvc,omitempty"`
+	PVCError         string             `json:"pvcError,omitempty"`
+	PV               *PVDiagnosis       `json:"pv,omitempty"`
+	PVError          string             `json:"pvError,omitempty"`
+	VS               *SnapshotDiagnosis `json:"vs,omitempty"`
+	VSError          string             `json:"vsError,omitempty"`
+	VSC              *SnapshotDiagnosis `json:"vsc,omitempty"`
+	VSCError         string             `json:"vscError,omitempty"`
+}
+
+// PodDiagnosis is the diagnostic info of the backup pod
+type PodDiagnosis struct {
+	Namespace  string                  `json:"namespace"`
+	Name       string                  `json:"name"`
+	Phase      corev1api.PodPhase      `json:"phase"`
+	NodeName   string                  `json:"nodeName,omitempty"`
+	Conditions []PodConditionDiagnosis `json:"conditions,omitempty"`
+}
+
+// PodConditionDiagnosis is the diagnostic info of one condition of the backup pod
+type PodConditionDiagnosis struct {
+	Type    corev1api.PodConditionType `json:"type"`
+	Status  corev1api.ConditionStatus  `json:"status"`
+	Reason  string                     `json:"reason,omitempty"`
+	Message string                     `json:"message,omitempty"`
+}
+
+// PVCDiagnosis is the diagnostic info of the backup PVC
+type PVCDiagnosis struct {
+	Namespace  string                               `json:"namespace"`
+	Name       string                               `json:"name"`
+	Phase      corev1api.PersistentVolumeClaimPhase `json:"phase"`
+	VolumeName string                               `json:"volumeName,omitempty"`
+
+	// StorageClass and WaitForFirstConsumer are set when the PVC is pending for its storage class in WaitForFirstConsumer binding mode,
+	// i.e., it is not bound until the backup pod is scheduled
+	StorageClass         string `json:"storageClass,omitempty"`
+	WaitForFirstConsumer bool   `json:"waitForFirstConsumer,omitempty"`
+}
+
+// PVDiagnosis is the diagnostic info of the PV bound to the backup PVC
+type PVDiagnosis struct {
+	Name    string                          `json:"name"`
+	Phase   corev1api.PersistentVolumePhase `json:"phase"`
+	Reason  string                          `json:"reason,omitempty"`
+	Message string                          `json:"message,omitempty"`
+}
+
+// SnapshotDiagnosis is the diagnostic info of the backup VolumeSnapshot or VolumeSnapshotContent.
+// For a VolumeSnapshot, BoundTo is the bound VolumeSnapshotContent; for a VolumeSnapshotContent, Handle is the snapshot handle.
+type SnapshotDiagnosis struct {
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name"`
+	BoundTo      string `json:"boundTo,omitempty"`
+	Handle       string `json:"handle,omitempty"`
+	ReadyToUse   bool   `json:"readyToUse"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// String formats the diagnosis in the same text as DiagnoseExpose, the objects are formatted by the diagnose helpers of kube and csi
+func (d *ExposeDiagnosis) String() string {
+	diag := "begin diagnose CSI exposer\n"
+
+	if d.PodError != "" {
+		diag += d.PodError + "\n"
+	}
+
+	if d.PVCError != "" {
+		diag += d.PVCError + "\n"
+	}
+
+	if d.VSError != "" {
+		diag += d.VSError + "\n"
+	}
+
+	if d.Pod != nil {
+		diag += kube.DiagnosePod(d.Pod.toPod())
+
+		if d.NodeAgentError != "" {
+			diag += d.NodeAgentError + "\n"
+		}
+
+		if d.PodLogsError != "" {
+			diag += d.PodLogsError + "\n"
+		} else if d.PodLogs != "" {
+			diag += "Backup pod logs:\n" + d.PodLogs
+			if !strings.HasSuffix(d.PodLogs, "\n") {
+				diag += "\n"
+			}
+		}
+	}
+
+	if d.PVC != nil {
+		diag += kube.DiagnosePVC(d.PVC.toPVC())
+
+		if d.PVC.WaitForFirstConsumer {
+			diag += fmt.Sprintf("PVC %s/%s waits for the first consumer by storage class %s, it is not bound until the backup pod is scheduled\n", d.PVC.Namespace, d.PVC.Name, d.PVC.StorageClass)
+
+			if d.Pod != nil {
+				for _, condition := range d.Pod.Conditions {
+					if isPodUnschedulableCondition(condition) {
+						diag += fmt.Sprintf("Backup pod %s/%s is unschedulable, reason %s, message %s\n", d.Pod.Namespace, d.Pod.Name, condition.Reason, condition.Message)
+					}
+				}
+			}
+		}
+
+		if d.PVError != "" {
+			diag += d.PVError + "\n"
+		} else if d.PV != nil {
+			diag += kube.DiagnosePV(d.PV.toPV())
+		}
+	}
+
+	if d.VS != nil {
+		diag += csi.DiagnoseVS(d.VS.toVS())
+
+		if d.VSCError != "" {
+			diag += d.VSCError + "\n"
+		} else if d.VSC != nil {
+			diag += csi.DiagnoseVSC(d.VSC.toVSC())
+		}
+	}
+
+	diag += "end diagnose CSI exposer"
+
+	return diag
+}
+
+// isPodUnschedulableCondition checks if the condition reports the pod can't be scheduled
+func isPodUnschedulableCondition(condition PodConditionDiagnosis) bool {
+	return condition.Type == corev1api.PodScheduled && condition.Status == corev1api.ConditionFalse && condition.Reason == corev1api.PodReasonUnschedulable
+}
+
+// toPod converts the diagnosis back to the pod fields formatted by kube.DiagnosePod
+func (p *PodDiagnosis) toPod() *corev1api.Pod {
+	pod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: p.Namespace, Name: p.Name},
+		Spec:       corev1api.PodSpec{NodeName: p.NodeName},
+		Status:     corev1api.PodStatus{Phase: p.Phase},
+	}
+
+	for _, condition := range p.Conditions {
+		pod.Status.Conditions = append(pod.Status.Conditions, corev1api.PodCondition{
+			Type:    condition.Type,
+			Status:  condition.Status,
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+
+	return pod
+}
+
+// toPVC converts the diagnosis back to the PVC fields formatted by kube.DiagnosePVC
+func (p *PVCDiagnosis) toPVC() *corev1api.PersistentVolumeClaim {
+	return &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: p.Namespace, Name: p.Name},
+		Spec:       corev1api.PersistentVolumeClaimSpec{VolumeName: p.VolumeName},
+		Status:     corev1api.PersistentVolumeClaimStatus{Phase: p.Phase},
+	}
+}
+
+// toPV converts the diagnosis back to the PV fields formatted by kube.DiagnosePV
+func (p *PVDiagnosis) toPV() *corev1api.PersistentVolume {
+	return &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: p.Name},
+		Status:     corev1api.PersistentVolumeStatus{Phase: p.Phase, Reason: p.Reason, Message: p.Message},
+	}
+}
+
+// toVS converts the diagnosis back to the VolumeSnapshot fields formatted by csi.DiagnoseVS
+func (s *SnapshotDiagnosis) toVS() *snapshotv1api.VolumeSnapshot {
+	return &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Namespace: s.Namespace, Name: s.Name},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &s.BoundTo,
+			ReadyToUse:                     &s.ReadyToUse,
+			Error:                          &snapshotv1api.VolumeSnapshotError{Message: &s.ErrorMessage},
+		},
+	}
+}
+
+// toVSC converts the diagnosis back to the VolumeSnapshotContent fields formatted by csi.DiagnoseVSC
+func (s *SnapshotDiagnosis) toVSC() *snapshotv1api.VolumeSnapshotContent {
+	return &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: s.Name},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			SnapshotHandle: &s.Handle,
+			ReadyToUse:     &s.ReadyToUse,
+			Error:          &snapshotv1api.VolumeSnapshotError{Message: &s.ErrorMessage},
+		},
+	}
+}
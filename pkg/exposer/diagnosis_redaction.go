@@ -0,0 +1,120 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+)
+
+// redactedValue replaces the values of the sensitive keys in the diagnostic info
+const redactedValue = "***"
+
+// defaultRedactKeyPatterns are the well-known sensitive keys, which are always redacted from the diagnostic info
+var defaultRedactKeyPatterns = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"api[-_]?key",
+	"access[-_]?key",
+	"credential",
+	"authorization",
+}
+
+// keyValuePattern matches the key-value pairs in the diagnostic text, e.g., key=value, --key=value, key: value and "key":"value".
+// An authorization scheme is taken as a part of the value, e.g., "Authorization: Bearer xxx"
+var keyValuePattern = regexp.MustCompile(`([\w.\-/]+)("?\s*[:=]\s*)("[^"]*"|'[^']*'|(?i:bearer|basic)\s+[^\s,;&"'}\]]+|[^\s,;&"'}\]]+)`)
+
+// diagnoseRedactor redacts the values of the keys matching any of the patterns from the diagnostic info,
+// so that the secrets, e.g., copied from the annotations of the source snapshot, are not leaked into logs or support bundles
+type diagnoseRedactor struct {
+	keyPatterns []*regexp.Regexp
+}
+
+func newDiagnoseRedactor(extraKeyPatterns []string, log logrus.FieldLogger) *diagnoseRedactor {
+	r := &diagnoseRedactor{}
+	for _, pattern := range append(append([]string{}, defaultRedactKeyPatterns...), extraKeyPatterns...) {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			log.WithError(err).Warnf("Skip invalid diagnose redaction key pattern %s", pattern)
+			continue
+		}
+
+		r.keyPatterns = append(r.keyPatterns, re)
+	}
+
+	return r
+}
+
+func (r *diagnoseRedactor) isSensitiveKey(key string) bool {
+	for _, re := range r.keyPatterns {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redact replaces the values of the sensitive keys in the text, the quotes around the values are kept
+func (r *diagnoseRedactor) redact(text string) string {
+	if text == "" {
+		return text
+	}
+
+	return keyValuePattern.ReplaceAllStringFunc(text, func(match string) string {
+		groups := keyValuePattern.FindStringSubmatch(match)
+		if !r.isSensitiveKey(groups[1]) {
+			return match
+		}
+
+		value := redactedValue
+		if quote := groups[3][0]; quote == '"' || quote == '\'' {
+			value = string(quote) + redactedValue + string(quote)
+		}
+
+		return groups[1] + groups[2] + value
+	})
+}
+
+// redactDiagnosis redacts the free-form texts of the diagnosis in place
+func (r *diagnoseRedactor) redactDiagnosis(diag *ExposeDiagnosis) {
+	for _, text := range []*string{&diag.PodError, &diag.NodeAgentError, &diag.PodLogs, &diag.PodLogsError,
+		&diag.PVCError, &diag.PVError, &diag.VSError, &diag.VSCError} {
+		*text = r.redact(*text)
+	}
+
+	if diag.Pod != nil {
+		for i := range diag.Pod.Conditions {
+			diag.Pod.Conditions[i].Message = r.redact(diag.Pod.Conditions[i].Message)
+		}
+	}
+
+	if diag.PV != nil {
+		diag.PV.Message = r.redact(diag.PV.Message)
+	}
+
+	if diag.VS != nil {
+		diag.VS.ErrorMessage = r.redact(diag.VS.ErrorMessage)
+	}
+
+	if diag.VSC != nil {
+		diag.VSC.ErrorMessage = r.redact(diag.VSC.ErrorMessage)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestDiagnoseRedactorRedact(t *testing.T) {
+	tests := []struct {
+		name          string
+		extraPatterns []string
+		text          string
+		expected      string
+	}{
+		{
+			name:     "empty text",
+			text:     "",
+			expected: "",
+		},
+		{
+			name:     "no sensitive key",
+			text:     "endpoint=https://s3.example.com region: us-east-1",
+			expected: "endpoint=https://s3.example.com region: us-east-1",
+		},
+		{
+			name:     "default sensitive keys",
+			text:     "--token=abc123 password: p@ss access_key=AKIA secret-name=foo",
+			expected: "--token=*** password: *** access_key=*** secret-name=***",
+		},
+		{
+			name:     "case insensitive",
+			text:     "X-Api-Key=abc Authorization: Bearer fake-bearer-token",
+			expected: "X-Api-Key=*** Authorization: ***",
+		},
+		{
+			name:     "quoted values",
+			text:     `{"token":"abc 123","name":"fake-vs"} apiKey='xyz'`,
+			expected: `{"token":"***","name":"fake-vs"} apiKey='***'`,
+		},
+		{
+			name:          "extra pattern",
+			extraPatterns: []string{"^endpoint$"},
+			text:          "endpoint=https://s3.example.com region=us-east-1",
+			expected:      "endpoint=*** region=us-east-1",
+		},
+		{
+			name:          "invalid extra pattern is skipped",
+			extraPatterns: []string{"("},
+			text:          "token=abc region=us-east-1",
+			expected:      "token=*** region=us-east-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := newDiagnoseRedactor(test.extraPatterns, velerotest.NewLogger())
+			assert.Equal(t, test.expected, r.redact(test.text))
+		})
+	}
+}
+
+func TestDiagnoseExposeRedaction(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: "velero",
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	backupVS := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			Error: &snapshotv1api.VolumeSnapshotError{
+				Message: pointer.String("failed to create snapshot with endpoint=https://s3.example.com, token=abc123"),
+			},
+		},
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+		Status: corev1api.PodStatus{
+			Phase: corev1api.PodPending,
+			Conditions: []corev1api.PodCondition{
+				{
+					Type:    corev1api.PodScheduled,
+					Status:  corev1api.ConditionFalse,
+					Message: "secret=fake-secret not found",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     []CSISnapshotExposerOption
+		expected []string
+		redacted []string
+	}{
+		{
+			name:     "default keys are redacted",
+			expected: []string{"endpoint=https://s3.example.com", "token=***", "secret=***"},
+			redacted: []string{"abc123", "fake-secret"},
+		},
+		{
+			name:     "extra keys are redacted",
+			opts:     []CSISnapshotExposerOption{WithDiagnoseRedaction("endpoint")},
+			expected: []string{"endpoint=***", "token=***", "secret=***"},
+			redacted: []string{"abc123", "fake-secret", "s3.example.com"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := NewCSISnapshotExposer(fake.NewSimpleClientset(backupPod), snapshotFake.NewSimpleClientset(backupVS).SnapshotV1(), velerotest.NewLogger(), test.opts...).(*csiSnapshotExposer)
+
+			diag, err := e.DiagnoseExposeStructured(context.Background(), ownerObject)
+			require.NoError(t, err)
+			require.NotNil(t, diag.VS)
+			require.NotNil(t, diag.Pod)
+
+			text := e.DiagnoseExpose(context.Background(), ownerObject)
+			for _, expected := range test.expected {
+				assert.Contains(t, text, expected)
+			}
+
+			for _, redacted := range test.redacted {
+				assert.NotContains(t, text, redacted)
+				assert.NotContains(t, diag.VS.ErrorMessage, redacted)
+				assert.NotContains(t, diag.Pod.Conditions[0].Message, redacted)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// The errors returned by the exposers are wrapped with the below sentinel errors,
+// so that callers could use errors.Is to decide how to handle the failure, e.g., requeue or fail fast.
+var (
+	ErrInvalidExposeParam           = errors.New("invalid expose param")
+	ErrSnapshotNotReady             = errors.New("snapshot not ready")
+	ErrSnapshotContentNotFound      = errors.New("snapshot content not found")
+	ErrBackupSnapshotCreateFailed   = errors.New("backup snapshot create failed")
+	ErrSourceSnapshotCleanupFailed  = errors.New("source snapshot cleanup failed")
+	ErrUnsupportedAccessMode        = errors.New("unsupported access mode")
+	ErrBackupPVCCreateFailed        = errors.New("backup PVC create failed")
+	ErrBackupPodCreateFailed        = errors.New("backup pod create failed")
+	ErrVolumeSizeExceedsMax         = errors.New("volume size exceeds max")
+	ErrNodeNotReady                 = errors.New("node not ready")
+	ErrSnapshotControllerNotRunning = errors.New("snapshot controller not running")
+	ErrVolumeSizeMismatch           = errors.New("volume size mismatch")
+	ErrSnapshotFailed               = errors.New("snapshot failed")
+	ErrExposeConflict               = errors.New("expose conflict")
+	ErrSnapshotClassNotFound        = errors.New("snapshot class not found")
+)
+
+// exposeError attaches a sentinel error to an error without changing its message
+type exposeError struct {
+	kind error
+	err  error
+}
+
+func (e *exposeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exposeError) Unwrap() []error {
+	return []error{e.kind, e.err}
+}
+
+// withKind marks the err with the sentinel error kind, it returns nil if err is nil
+func withKind(kind error, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &exposeError{kind: kind, err: err}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+)
+
+// exposeGate bounds the number of the exposes creating objects concurrently, so that a large number of exposes started at once,
+// e.g., by hundreds of DataUploads, don't create the backup VS, VSC, PVC and pod in a burst to the API server
+type exposeGate struct {
+	slots chan struct{}
+}
+
+func newExposeGate(maxConcurrent int) *exposeGate {
+	return &exposeGate{
+		slots: make(chan struct{}, maxConcurrent),
+	}
+}
+
+// acquire blocks until a slot is available or the context is done, a nil gate never blocks.
+// The returned func releases the slot and must be called once the create phase completes
+func (g *exposeGate) acquire(ctx context.Context) (func(), error) {
+	if g == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case g.slots <- struct{}{}:
+		return func() { <-g.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestExposeGate(t *testing.T) {
+	var gate *exposeGate
+	release, err := gate.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+
+	gate = newExposeGate(2)
+
+	release1, err := gate.acquire(context.Background())
+	require.NoError(t, err)
+
+	release2, err := gate.acquire(context.Background())
+	require.NoError(t, err)
+
+	// the third expose waits for a slot until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = gate.acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the queued expose gets the slot once a running one releases it
+	acquired := make(chan struct{})
+	go func() {
+		release3, err := gate.acquire(context.Background())
+		if err == nil {
+			release3()
+		}
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("expose acquired a slot while the gate is full")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	release1()
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expose didn't acquire the released slot")
+	}
+
+	release2()
+	assert.Empty(t, gate.slots)
+}
+
+func TestWithMaxConcurrentExpose(t *testing.T) {
+	e := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger()).(*csiSnapshotExposer)
+	assert.Nil(t, e.exposeGate)
+
+	e = NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), WithMaxConcurrentExpose(0)).(*csiSnapshotExposer)
+	assert.Nil(t, e.exposeGate)
+
+	e = NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), WithMaxConcurrentExpose(3)).(*csiSnapshotExposer)
+	require.NotNil(t, e.exposeGate)
+	assert.Equal(t, 3, cap(e.exposeGate.slots))
+}
@@ -0,0 +1,51 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"fmt"
+
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ExposePlan is the resolved plan of a snapshot expose, i.e., what Expose would create for the snapshot.
+// SnapshotContent and Driver are empty if the snapshot is not bound to a VolumeSnapshotContent yet.
+// RestoreSize is the resolved size of the snapshot, while VolumeSize is the size of the backupPVC including the overhead.
+type ExposePlan struct {
+	SnapshotNamespace         string                               `json:"snapshotNamespace"`
+	SnapshotName              string                               `json:"snapshotName"`
+	SnapshotReady             bool                                 `json:"snapshotReady"`
+	SnapshotContent           string                               `json:"snapshotContent,omitempty"`
+	Driver                    string                               `json:"driver,omitempty"`
+	BackupVolumeSnapshotClass string                               `json:"backupVolumeSnapshotClass,omitempty"`
+	BackupPVCStorageClass     string                               `json:"backupPVCStorageClass,omitempty"`
+	BackupPVCAccessMode       corev1api.PersistentVolumeAccessMode `json:"backupPVCAccessMode"`
+	BackupPVCReadOnly         bool                                 `json:"backupPVCReadOnly"`
+	SPCNoRelabeling           bool                                 `json:"spcNoRelabeling"`
+	VolumeMode                corev1api.PersistentVolumeMode       `json:"volumeMode"`
+	RestoreSize               resource.Quantity                    `json:"restoreSize"`
+	VolumeSize                resource.Quantity                    `json:"volumeSize"`
+	NodeOS                    string                               `json:"nodeOS"`
+}
+
+// String formats the plan in a single line for logging
+func (p *ExposePlan) String() string {
+	return fmt.Sprintf("snapshot %s/%s (ready %v, content %s, driver %s, restore size %s), backup VS class %s, backup PVC storage class %s (access mode %s, readOnly %v, spcNoRelabeling %v), volume mode %s, size %s, node OS %s",
+		p.SnapshotNamespace, p.SnapshotName, p.SnapshotReady, p.SnapshotContent, p.Driver, formatQuantity(p.RestoreSize), p.BackupVolumeSnapshotClass, p.BackupPVCStorageClass,
+		p.BackupPVCAccessMode, p.BackupPVCReadOnly, p.SPCNoRelabeling, p.VolumeMode, formatQuantity(p.VolumeSize), p.NodeOS)
+}
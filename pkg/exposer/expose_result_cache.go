@@ -0,0 +1,111 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"sync"
+	"time"
+
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+)
+
+// exposeResultCache caches the result of GetExposed for a short period, keyed by the owner and the resourceVersion of the backup pod,
+// so that the repeated calls in the reconcile loop don't read the backupPVC and PV again while the backup pod is not changed
+type exposeResultCache struct {
+	ttl   time.Duration
+	clock clock.Clock
+
+	lock    sync.Mutex
+	entries map[string]exposeResultCacheEntry
+}
+
+type exposeResultCacheEntry struct {
+	resourceVersion string
+	result          ExposeResult
+	expires         time.Time
+}
+
+func newExposeResultCache(ttl time.Duration) *exposeResultCache {
+	return &exposeResultCache{
+		ttl:     ttl,
+		clock:   clock.RealClock{},
+		entries: map[string]exposeResultCacheEntry{},
+	}
+}
+
+func exposeResultCacheKey(ownerObject corev1api.ObjectReference) string {
+	return ownerObject.Namespace + "/" + ownerObject.Name + "/" + string(ownerObject.UID)
+}
+
+// get returns the cached result of the owner if it is not expired and the backup pod is still in the cached resourceVersion
+func (c *exposeResultCache) get(ownerObject corev1api.ObjectReference, resourceVersion string) *ExposeResult {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := exposeResultCacheKey(ownerObject)
+
+	entry, found := c.entries[key]
+	if !found {
+		return nil
+	}
+
+	if entry.resourceVersion != resourceVersion || !c.clock.Now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil
+	}
+
+	return copyExposeResult(&entry.result)
+}
+
+func (c *exposeResultCache) set(ownerObject corev1api.ObjectReference, resourceVersion string, result *ExposeResult) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries[exposeResultCacheKey(ownerObject)] = exposeResultCacheEntry{
+		resourceVersion: resourceVersion,
+		result:          *copyExposeResult(result),
+		expires:         c.clock.Now().Add(c.ttl),
+	}
+}
+
+func (c *exposeResultCache) delete(ownerObject corev1api.ObjectReference) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, exposeResultCacheKey(ownerObject))
+}
+
+// copyExposeResult copies the result, so that the cached one is not changed by the callers
+func copyExposeResult(result *ExposeResult) *ExposeResult {
+	copied := *result
+	if result.ByPod.HostingPod != nil {
+		copied.ByPod.HostingPod = result.ByPod.HostingPod.DeepCopy()
+
+		// the scheduling refers to the spec of the hosting pod, so get it from the copied pod
+		if result.ByPod.Scheduling != nil {
+			copied.ByPod.Scheduling = getExposeScheduling(copied.ByPod.HostingPod)
+		}
+	}
+
+	if result.ByPod.NodeOS != nil {
+		nodeOS := *result.ByPod.NodeOS
+		copied.ByPod.NodeOS = &nodeOS
+	}
+
+	return &copied
+}
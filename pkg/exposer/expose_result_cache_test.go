@@ -0,0 +1,203 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	testclocks "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestExposeResultCache(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	otherOwner := ownerObject
+	otherOwner.UID = "other-uid"
+
+	nodeOS := "linux"
+	result := &ExposeResult{ByPod: ExposeByPod{
+		HostingPod: &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ownerObject.Namespace,
+				Name:      ownerObject.Name,
+			},
+			Spec: corev1api.PodSpec{
+				NodeSelector: map[string]string{"fake-key": "fake-value"},
+			},
+		},
+		HostingContainer: "fake-uid",
+		VolumeName:       "fake-uid",
+		NodeOS:           &nodeOS,
+	}}
+	result.ByPod.Scheduling = getExposeScheduling(result.ByPod.HostingPod)
+
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	cache := newExposeResultCache(time.Minute)
+	cache.clock = fakeClock
+
+	assert.Nil(t, cache.get(ownerObject, "1"))
+
+	cache.set(ownerObject, "1", result)
+
+	cached := cache.get(ownerObject, "1")
+	require.NotNil(t, cached)
+	assert.Equal(t, result, cached)
+
+	// the cached result is not changed by the callers
+	cached.ByPod.HostingPod.Spec.NodeSelector["fake-key"] = "other-value"
+	*cached.ByPod.NodeOS = "windows"
+	assert.Equal(t, result, cache.get(ownerObject, "1"))
+
+	// the owner with the same name but a different UID doesn't share the cache
+	assert.Nil(t, cache.get(otherOwner, "1"))
+
+	// the cached result is invalidated by a new resource version
+	assert.Nil(t, cache.get(ownerObject, "2"))
+	assert.Nil(t, cache.get(ownerObject, "1"))
+
+	// the cached result expires after ttl
+	cache.set(ownerObject, "2", result)
+	fakeClock.Step(59 * time.Second)
+	assert.NotNil(t, cache.get(ownerObject, "2"))
+	fakeClock.Step(time.Second)
+	assert.Nil(t, cache.get(ownerObject, "2"))
+
+	// the cached result is deleted
+	cache.set(ownerObject, "3", result)
+	cache.delete(ownerObject)
+	assert.Nil(t, cache.get(ownerObject, "3"))
+}
+
+func TestGetExposedCached(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: "fake-node",
+			Volumes: []corev1api.Volume{
+				{
+					Name: string(ownerObject.UID),
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv-name",
+		},
+	}
+
+	backupPV := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv-name",
+		},
+	}
+
+	kubeClient := fake.NewSimpleClientset(backupPVC, backupPV)
+	nodeClient := velerotest.NewFakeControllerRuntimeClient(t, backupPod)
+
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	exposer := csiSnapshotExposer{
+		kubeClient:        kubeClient,
+		log:               velerotest.NewLogger(),
+		exposeResultCache: newExposeResultCache(time.Minute),
+	}
+	exposer.exposeResultCache.clock = fakeClock
+
+	pvcGets := func() int {
+		count := 0
+		for _, action := range kubeClient.Actions() {
+			if action.GetVerb() == "get" && action.GetResource().Resource == "persistentvolumeclaims" {
+				count++
+			}
+		}
+
+		return count
+	}
+
+	getExposed := func() *ExposeResult {
+		result, err := exposer.GetExposed(context.Background(), ownerObject, time.Second, &CSISnapshotExposeWaitParam{
+			NodeClient: nodeClient,
+			NodeName:   "fake-node",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		return result
+	}
+
+	first := getExposed()
+	assert.Equal(t, 1, pvcGets())
+
+	// cache hit
+	second := getExposed()
+	assert.Equal(t, 1, pvcGets())
+	assert.Equal(t, first, second)
+
+	// the backup pod is changed, so the cache is invalidated
+	pod := &corev1api.Pod{}
+	require.NoError(t, nodeClient.Get(context.Background(), client.ObjectKey{Namespace: ownerObject.Namespace, Name: ownerObject.Name}, pod))
+	pod.Labels = map[string]string{"fake-key": "fake-value"}
+	require.NoError(t, nodeClient.Update(context.Background(), pod))
+
+	third := getExposed()
+	assert.Equal(t, 2, pvcGets())
+	assert.Equal(t, map[string]string{"fake-key": "fake-value"}, third.ByPod.HostingPod.Labels)
+
+	getExposed()
+	assert.Equal(t, 2, pvcGets())
+
+	// the cache expires
+	fakeClock.Step(time.Minute)
+	getExposed()
+	assert.Equal(t, 3, pvcGets())
+
+	// the cached result is deleted, e.g., by CleanUp
+	exposer.exposeResultCache.delete(ownerObject)
+	getExposed()
+	assert.Equal(t, 4, pvcGets())
+}
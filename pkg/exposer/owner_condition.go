@@ -0,0 +1,141 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ExposeConditionType is the type of the condition maintained in the status of the owner object to report the state of the expose
+const ExposeConditionType = "Exposed"
+
+// Reasons of the expose condition
+const (
+	// ExposeConditionReasonProgressing means the expose is in progress, the status of the condition is Unknown
+	ExposeConditionReasonProgressing = "Progressing"
+
+	// ExposeConditionReasonReady means the backup pod is running and the backup PVC is bound, the status of the condition is True
+	ExposeConditionReasonReady = "Ready"
+
+	// ExposeConditionReasonFailed means the expose failed, the status of the condition is False
+	ExposeConditionReasonFailed = "Failed"
+)
+
+// exposeConditionStatus returns the status of the expose condition with the reason
+func exposeConditionStatus(reason string) metav1.ConditionStatus {
+	switch reason {
+	case ExposeConditionReasonReady:
+		return metav1.ConditionTrue
+	case ExposeConditionReasonFailed:
+		return metav1.ConditionFalse
+	default:
+		return metav1.ConditionUnknown
+	}
+}
+
+// setExposeCondition sets the expose condition in status.conditions of the owner object through the status subresource,
+// the owner is not patched if the condition is not changed
+func setExposeCondition(ctx context.Context, ownerClient client.Client, ownerObject corev1api.ObjectReference, reason string, message string) error {
+	gv, err := schema.ParseGroupVersion(ownerObject.APIVersion)
+	if err != nil {
+		return errors.Wrapf(err, "error to parse API version of owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		owner := &unstructured.Unstructured{}
+		owner.SetGroupVersionKind(gv.WithKind(ownerObject.Kind))
+
+		if err := ownerClient.Get(ctx, client.ObjectKey{Namespace: ownerObject.Namespace, Name: ownerObject.Name}, owner); err != nil {
+			return errors.Wrapf(err, "error to get owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+		}
+
+		if ownerObject.UID != "" && owner.GetUID() != ownerObject.UID {
+			return errors.Errorf("owner %s/%s has a different UID %s", ownerObject.Namespace, ownerObject.Name, owner.GetUID())
+		}
+
+		conditions, err := getOwnerConditions(owner)
+		if err != nil {
+			return errors.Wrapf(err, "error to get conditions of owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+		}
+
+		changed := meta.SetStatusCondition(&conditions, metav1.Condition{
+			Type:               ExposeConditionType,
+			Status:             exposeConditionStatus(reason),
+			ObservedGeneration: owner.GetGeneration(),
+			Reason:             reason,
+			Message:            message,
+		})
+		if !changed {
+			return nil
+		}
+
+		original := owner.DeepCopy()
+		if err := setOwnerConditions(owner, conditions); err != nil {
+			return errors.Wrapf(err, "error to set conditions of owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+		}
+
+		return ownerClient.Status().Patch(ctx, owner, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+	})
+}
+
+func getOwnerConditions(owner *unstructured.Unstructured) ([]metav1.Condition, error) {
+	items, _, err := unstructured.NestedSlice(owner.Object, "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make([]metav1.Condition, 0, len(items))
+	for _, item := range items {
+		content, ok := item.(map[string]any)
+		if !ok {
+			return nil, errors.Errorf("unexpected condition %v", item)
+		}
+
+		condition := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &condition); err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+func setOwnerConditions(owner *unstructured.Unstructured, conditions []metav1.Condition) error {
+	items := make([]any, 0, len(conditions))
+	for i := range conditions {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&conditions[i])
+		if err != nil {
+			return err
+		}
+
+		items = append(items, content)
+	}
+
+	return unstructured.SetNestedSlice(owner.Object, items, "status", "conditions")
+}
@@ -0,0 +1,350 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8sfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+var conditionTestOwnerGVK = schema.GroupVersionKind{Group: "example.io", Version: "v1", Kind: "FakeOwner"}
+
+func newConditionTestOwner(conditions ...metav1.Condition) *unstructured.Unstructured {
+	owner := &unstructured.Unstructured{}
+	owner.SetGroupVersionKind(conditionTestOwnerGVK)
+	owner.SetNamespace("velero")
+	owner.SetName("fake-owner")
+	owner.SetUID("fake-uid")
+	owner.SetGeneration(2)
+
+	if len(conditions) > 0 {
+		if err := setOwnerConditions(owner, conditions); err != nil {
+			panic(err)
+		}
+	}
+
+	return owner
+}
+
+func conditionTestOwnerRef(uid string) corev1api.ObjectReference {
+	return corev1api.ObjectReference{
+		APIVersion: conditionTestOwnerGVK.GroupVersion().String(),
+		Kind:       conditionTestOwnerGVK.Kind,
+		Namespace:  "velero",
+		Name:       "fake-owner",
+		UID:        types.UID(uid),
+	}
+}
+
+// newConditionTestClient creates a client with the owner, the reasons of the expose condition are recorded in order for each status patch
+func newConditionTestClient(owner *unstructured.Unstructured, reasons *[]string) client.Client {
+	builder := k8sfake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithInterceptorFuncs(interceptor.Funcs{
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			conditions, err := getOwnerConditions(obj.(*unstructured.Unstructured))
+			if err != nil {
+				return err
+			}
+
+			if condition := meta.FindStatusCondition(conditions, ExposeConditionType); condition != nil {
+				*reasons = append(*reasons, condition.Reason)
+			}
+
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+	})
+
+	if owner != nil {
+		builder = builder.WithObjects(owner).WithStatusSubresource(owner)
+	}
+
+	return builder.Build()
+}
+
+func getConditionTestOwnerConditions(t *testing.T, c client.Client) []metav1.Condition {
+	t.Helper()
+
+	owner := &unstructured.Unstructured{}
+	owner.SetGroupVersionKind(conditionTestOwnerGVK)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "fake-owner"}, owner))
+
+	conditions, err := getOwnerConditions(owner)
+	require.NoError(t, err)
+
+	return conditions
+}
+
+func TestSetExposeCondition(t *testing.T) {
+	otherCondition := metav1.Condition{
+		Type:               "Other",
+		Status:             metav1.ConditionTrue,
+		Reason:             "OtherReason",
+		LastTransitionTime: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	progressing := metav1.Condition{
+		Type:               ExposeConditionType,
+		Status:             metav1.ConditionUnknown,
+		Reason:             ExposeConditionReasonProgressing,
+		Message:            "fake-message",
+		ObservedGeneration: 2,
+		LastTransitionTime: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name               string
+		owner              *unstructured.Unstructured
+		ownerUID           string
+		reason             string
+		message            string
+		expectedErr        string
+		expectedConditions []metav1.Condition
+		expectedPatches    []string
+	}{
+		{
+			name:        "owner not found",
+			ownerUID:    "fake-uid",
+			reason:      ExposeConditionReasonProgressing,
+			expectedErr: "error to get owner velero/fake-owner: fakeowners.example.io \"fake-owner\" not found",
+		},
+		{
+			name:        "owner UID mismatch",
+			owner:       newConditionTestOwner(),
+			ownerUID:    "other-uid",
+			reason:      ExposeConditionReasonProgressing,
+			expectedErr: "owner velero/fake-owner has a different UID fake-uid",
+		},
+		{
+			name:     "condition is added",
+			owner:    newConditionTestOwner(otherCondition),
+			ownerUID: "fake-uid",
+			reason:   ExposeConditionReasonProgressing,
+			message:  "fake-message",
+			expectedConditions: []metav1.Condition{
+				otherCondition,
+				{
+					Type:               ExposeConditionType,
+					Status:             metav1.ConditionUnknown,
+					Reason:             ExposeConditionReasonProgressing,
+					Message:            "fake-message",
+					ObservedGeneration: 2,
+				},
+			},
+			expectedPatches: []string{ExposeConditionReasonProgressing},
+		},
+		{
+			name:     "condition is updated",
+			owner:    newConditionTestOwner(progressing, otherCondition),
+			ownerUID: "fake-uid",
+			reason:   ExposeConditionReasonFailed,
+			message:  "fake-error",
+			expectedConditions: []metav1.Condition{
+				{
+					Type:               ExposeConditionType,
+					Status:             metav1.ConditionFalse,
+					Reason:             ExposeConditionReasonFailed,
+					Message:            "fake-error",
+					ObservedGeneration: 2,
+				},
+				otherCondition,
+			},
+			expectedPatches: []string{ExposeConditionReasonFailed},
+		},
+		{
+			name:               "condition is not changed",
+			owner:              newConditionTestOwner(progressing),
+			ownerUID:           "fake-uid",
+			reason:             ExposeConditionReasonProgressing,
+			message:            "fake-message",
+			expectedConditions: []metav1.Condition{progressing},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var patches []string
+			ownerClient := newConditionTestClient(test.owner, &patches)
+
+			err := setExposeCondition(context.Background(), ownerClient, conditionTestOwnerRef(test.ownerUID), test.reason, test.message)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			conditions := getConditionTestOwnerConditions(t, ownerClient)
+			require.Len(t, conditions, len(test.expectedConditions))
+			for i := range conditions {
+				if test.expectedConditions[i].LastTransitionTime.IsZero() {
+					assert.False(t, conditions[i].LastTransitionTime.IsZero())
+				} else {
+					assert.True(t, test.expectedConditions[i].LastTransitionTime.Equal(&conditions[i].LastTransitionTime))
+				}
+
+				conditions[i].LastTransitionTime = metav1.Time{}
+				test.expectedConditions[i].LastTransitionTime = metav1.Time{}
+			}
+
+			assert.Equal(t, test.expectedConditions, conditions)
+			assert.Equal(t, test.expectedPatches, patches)
+		})
+	}
+}
+
+func TestExposeOwnerConditions(t *testing.T) {
+	ownerObject := conditionTestOwnerRef("fake-uid")
+
+	vscName := "fake-vsc"
+	vsObject := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-vs",
+			Namespace: "fake-ns",
+		},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &vscName,
+			ReadyToUse:                     boolptr.True(),
+		},
+	}
+
+	snapshotHandle := "fake-handle"
+	vscObj := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: vscName,
+		},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy: snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:         "fake-driver",
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{
+			SnapshotHandle: &snapshotHandle,
+		},
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	exposeParam := &CSISnapshotExposeParam{
+		SnapshotName:     "fake-vs",
+		SourceNamespace:  "fake-ns",
+		AccessMode:       AccessModeFileSystem,
+		OperationTimeout: time.Millisecond,
+		ExposeTimeout:    time.Millisecond,
+	}
+
+	t.Run("progressing to failed", func(t *testing.T) {
+		reasons := []string{}
+		ownerClient := newConditionTestClient(newConditionTestOwner(), &reasons)
+
+		exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), WithOwnerConditions(ownerClient))
+
+		// the snapshot doesn't exist, so the expose fails
+		err := exposer.Expose(context.Background(), ownerObject, exposeParam)
+		require.Error(t, err)
+
+		assert.Equal(t, []string{ExposeConditionReasonProgressing, ExposeConditionReasonFailed}, reasons)
+
+		condition := meta.FindStatusCondition(getConditionTestOwnerConditions(t, ownerClient), ExposeConditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Contains(t, condition.Message, "Failed to expose snapshot fake-ns/fake-vs")
+	})
+
+	t.Run("progressing to ready", func(t *testing.T) {
+		reasons := []string{}
+		ownerClient := newConditionTestClient(newConditionTestOwner(), &reasons)
+
+		kubeClient := fake.NewSimpleClientset(daemonSet)
+		exposer := NewCSISnapshotExposer(kubeClient, snapshotFake.NewSimpleClientset(vsObject, vscObj).SnapshotV1(), velerotest.NewLogger(), WithOwnerConditions(ownerClient))
+
+		require.NoError(t, exposer.Expose(context.Background(), ownerObject, exposeParam))
+
+		condition := meta.FindStatusCondition(getConditionTestOwnerConditions(t, ownerClient), ExposeConditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionUnknown, condition.Status)
+		assert.Equal(t, "Snapshot fake-ns/fake-vs is exposed, waiting for the backup pod", condition.Message)
+
+		// bind the backup PVC and schedule the backup pod
+		backupPV := &corev1api.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "fake-backup-pv",
+			},
+		}
+		_, err := kubeClient.CoreV1().PersistentVolumes().Create(context.Background(), backupPV, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		backupPVC, err := kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		backupPVC.Spec.VolumeName = backupPV.Name
+		_, err = kubeClient.CoreV1().PersistentVolumeClaims(ownerObject.Namespace).Update(context.Background(), backupPVC, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		backupPod, err := kubeClient.CoreV1().Pods(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		backupPod.ResourceVersion = ""
+		backupPod.Spec.NodeName = "fake-node"
+
+		result, err := exposer.GetExposed(context.Background(), ownerObject, time.Second, &CSISnapshotExposeWaitParam{
+			NodeClient: velerotest.NewFakeControllerRuntimeClient(t, backupPod),
+			NodeName:   "fake-node",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		assert.Equal(t, []string{ExposeConditionReasonProgressing, ExposeConditionReasonProgressing, ExposeConditionReasonReady}, reasons)
+
+		condition = meta.FindStatusCondition(getConditionTestOwnerConditions(t, ownerClient), ExposeConditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Equal(t, "Backup pod fake-owner is running in node fake-node", condition.Message)
+	})
+}
@@ -0,0 +1,81 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ExposeCleanUpFinalizer is added to the owner object during the expose, so that the deletion of the owner
+// is blocked until the objects generated by the expose are cleaned up
+const ExposeCleanUpFinalizer = "velero.io/expose-cleanup"
+
+// AddExposeFinalizer adds ExposeCleanUpFinalizer to the owner object if it doesn't exist
+func AddExposeFinalizer(ctx context.Context, ownerClient client.Client, ownerObject corev1api.ObjectReference) error {
+	return updateOwnerFinalizer(ctx, ownerClient, ownerObject, func(owner *unstructured.Unstructured) bool {
+		return controllerutil.AddFinalizer(owner, ExposeCleanUpFinalizer)
+	})
+}
+
+// RemoveExposeFinalizer removes ExposeCleanUpFinalizer from the owner object, it is a no-op if the owner doesn't exist
+func RemoveExposeFinalizer(ctx context.Context, ownerClient client.Client, ownerObject corev1api.ObjectReference) error {
+	err := updateOwnerFinalizer(ctx, ownerClient, ownerObject, func(owner *unstructured.Unstructured) bool {
+		return controllerutil.RemoveFinalizer(owner, ExposeCleanUpFinalizer)
+	})
+
+	if apierrors.IsNotFound(errors.Cause(err)) {
+		return nil
+	}
+
+	return err
+}
+
+func updateOwnerFinalizer(ctx context.Context, ownerClient client.Client, ownerObject corev1api.ObjectReference, update func(*unstructured.Unstructured) bool) error {
+	gv, err := schema.ParseGroupVersion(ownerObject.APIVersion)
+	if err != nil {
+		return errors.Wrapf(err, "error to parse API version of owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		owner := &unstructured.Unstructured{}
+		owner.SetGroupVersionKind(gv.WithKind(ownerObject.Kind))
+
+		if err := ownerClient.Get(ctx, client.ObjectKey{Namespace: ownerObject.Namespace, Name: ownerObject.Name}, owner); err != nil {
+			return errors.Wrapf(err, "error to get owner %s/%s", ownerObject.Namespace, ownerObject.Name)
+		}
+
+		if ownerObject.UID != "" && owner.GetUID() != ownerObject.UID {
+			return errors.Errorf("owner %s/%s has a different UID %s", ownerObject.Namespace, ownerObject.Name, owner.GetUID())
+		}
+
+		original := owner.DeepCopy()
+		if !update(owner) {
+			return nil
+		}
+
+		return ownerClient.Patch(ctx, owner, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+	})
+}
@@ -0,0 +1,197 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newFinalizerTestDataUpload(finalizers ...string) *velerov2alpha1.DataUpload {
+	return &velerov2alpha1.DataUpload{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+			Kind:       "DataUpload",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "velero",
+			Name:       "fake-du",
+			UID:        "fake-uid",
+			Finalizers: finalizers,
+		},
+	}
+}
+
+func TestAddExposeFinalizer(t *testing.T) {
+	tests := []struct {
+		name               string
+		owner              *velerov2alpha1.DataUpload
+		ownerUID           string
+		expectedFinalizers []string
+		expectedErr        string
+	}{
+		{
+			name:        "owner not found",
+			ownerUID:    "fake-uid",
+			expectedErr: "error to get owner velero/fake-du: datauploads.velero.io \"fake-du\" not found",
+		},
+		{
+			name:        "owner UID mismatch",
+			owner:       newFinalizerTestDataUpload(),
+			ownerUID:    "other-uid",
+			expectedErr: "owner velero/fake-du has a different UID fake-uid",
+		},
+		{
+			name:               "add finalizer",
+			owner:              newFinalizerTestDataUpload("other-finalizer"),
+			ownerUID:           "fake-uid",
+			expectedFinalizers: []string{"other-finalizer", ExposeCleanUpFinalizer},
+		},
+		{
+			name:               "finalizer exists",
+			owner:              newFinalizerTestDataUpload(ExposeCleanUpFinalizer),
+			ownerUID:           "fake-uid",
+			expectedFinalizers: []string{ExposeCleanUpFinalizer},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := []runtime.Object{}
+			if tt.owner != nil {
+				objs = append(objs, tt.owner)
+			}
+
+			ownerClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+			ownerObject := corev1api.ObjectReference{
+				APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+				Kind:       "DataUpload",
+				Namespace:  "velero",
+				Name:       "fake-du",
+				UID:        types.UID(tt.ownerUID),
+			}
+
+			err := AddExposeFinalizer(context.Background(), ownerClient, ownerObject)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			du := &velerov2alpha1.DataUpload{}
+			require.NoError(t, ownerClient.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "fake-du"}, du))
+			assert.Equal(t, tt.expectedFinalizers, du.Finalizers)
+		})
+	}
+}
+
+func TestRemoveExposeFinalizer(t *testing.T) {
+	tests := []struct {
+		name               string
+		owner              *velerov2alpha1.DataUpload
+		expectedFinalizers []string
+	}{
+		{
+			name: "owner not found",
+		},
+		{
+			name:               "remove finalizer",
+			owner:              newFinalizerTestDataUpload("other-finalizer", ExposeCleanUpFinalizer),
+			expectedFinalizers: []string{"other-finalizer"},
+		},
+		{
+			name:               "finalizer not exist",
+			owner:              newFinalizerTestDataUpload("other-finalizer"),
+			expectedFinalizers: []string{"other-finalizer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := []runtime.Object{}
+			if tt.owner != nil {
+				objs = append(objs, tt.owner)
+			}
+
+			ownerClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+			ownerObject := corev1api.ObjectReference{
+				APIVersion: velerov2alpha1.SchemeGroupVersion.String(),
+				Kind:       "DataUpload",
+				Namespace:  "velero",
+				Name:       "fake-du",
+				UID:        "fake-uid",
+			}
+
+			err := RemoveExposeFinalizer(context.Background(), ownerClient, ownerObject)
+			require.NoError(t, err)
+
+			if tt.owner != nil {
+				du := &velerov2alpha1.DataUpload{}
+				require.NoError(t, ownerClient.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "fake-du"}, du))
+				assert.Equal(t, tt.expectedFinalizers, du.Finalizers)
+			}
+		})
+	}
+}
+
+func TestExposeFinalizerHandshake(t *testing.T) {
+	owner := newFinalizerTestDataUpload()
+	ownerObject := corev1api.ObjectReference{
+		APIVersion: owner.APIVersion,
+		Kind:       owner.Kind,
+		Namespace:  owner.Namespace,
+		Name:       owner.Name,
+		UID:        owner.UID,
+	}
+
+	ownerClient := velerotest.NewFakeControllerRuntimeClient(t, owner)
+	exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), WithOwnerFinalizer(ownerClient))
+
+	getFinalizers := func() []string {
+		du := &velerov2alpha1.DataUpload{}
+		require.NoError(t, ownerClient.Get(context.Background(), client.ObjectKey{Namespace: owner.Namespace, Name: owner.Name}, du))
+		return du.Finalizers
+	}
+
+	// the snapshot doesn't exist, so the expose fails after the finalizer is added
+	err := exposer.Expose(context.Background(), ownerObject, &CSISnapshotExposeParam{
+		SnapshotName:     "fake-vs",
+		SourceNamespace:  "fake-ns",
+		OperationTimeout: time.Millisecond,
+		ExposeTimeout:    time.Millisecond,
+	})
+	require.Error(t, err)
+	assert.Equal(t, []string{ExposeCleanUpFinalizer}, getFinalizers())
+
+	exposer.CleanUp(context.Background(), ownerObject, "fake-vs", "fake-ns")
+	assert.Empty(t, getFinalizers())
+}
@@ -0,0 +1,85 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	corev1api "k8s.io/api/core/v1"
+)
+
+// PeekReason is the category of the unrecoverable failure of the hosting pod found by PeekExposed
+type PeekReason string
+
+const (
+	// PeekReasonImagePullBackOff means the image of a container in the hosting pod can't be pulled
+	PeekReasonImagePullBackOff PeekReason = "ImagePullBackOff"
+	// PeekReasonOOMKilled means a container in the hosting pod is killed for running out of memory
+	PeekReasonOOMKilled PeekReason = "OOMKilled"
+	// PeekReasonUnschedulable means the hosting pod failed without being scheduled to any node
+	PeekReasonUnschedulable PeekReason = "Unschedulable"
+	// PeekReasonContainerError means the hosting pod failed for any other reason, e.g., a container exits with an error
+	PeekReasonContainerError PeekReason = "ContainerError"
+)
+
+// PeekError is returned by PeekExposed when the hosting pod is unrecoverable, callers could use errors.As to get the reason,
+// e.g., to retry an Unschedulable or OOMKilled expose on a different node or with more resources, and fail the others permanently
+type PeekError struct {
+	Reason  PeekReason
+	Message string
+}
+
+func (e *PeekError) Error() string {
+	return e.Message
+}
+
+// newPeekError creates a PeekError with the reason parsed from the status of the unrecoverable pod
+func newPeekError(pod *corev1api.Pod, message string) *PeekError {
+	return &PeekError{
+		Reason:  getPeekReason(pod),
+		Message: message,
+	}
+}
+
+func getPeekReason(pod *corev1api.Pod) PeekReason {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil {
+			switch status.State.Waiting.Reason {
+			case "ImagePullBackOff", "ErrImagePull", "ErrImageNeverPull":
+				return PeekReasonImagePullBackOff
+			}
+		}
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.Reason == "OOMKilled" {
+			return PeekReasonOOMKilled
+		}
+
+		if status.LastTerminationState.Terminated != nil && status.LastTerminationState.Terminated.Reason == "OOMKilled" {
+			return PeekReasonOOMKilled
+		}
+	}
+
+	if pod.Spec.NodeName == "" {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1api.PodScheduled && condition.Status == corev1api.ConditionFalse && condition.Reason == corev1api.PodReasonUnschedulable {
+				return PeekReasonUnschedulable
+			}
+		}
+	}
+
+	return PeekReasonContainerError
+}
@@ -0,0 +1,140 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1api "k8s.io/api/core/v1"
+)
+
+func TestGetPeekReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		pod      *corev1api.Pod
+		expected PeekReason
+	}{
+		{
+			name: "image pull back off",
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{NodeName: "fake-node"},
+				Status: corev1api.PodStatus{
+					ContainerStatuses: []corev1api.ContainerStatus{
+						{
+							State: corev1api.ContainerState{
+								Waiting: &corev1api.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+							},
+						},
+					},
+				},
+			},
+			expected: PeekReasonImagePullBackOff,
+		},
+		{
+			name: "image never pull",
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{NodeName: "fake-node"},
+				Status: corev1api.PodStatus{
+					ContainerStatuses: []corev1api.ContainerStatus{
+						{
+							State: corev1api.ContainerState{
+								Waiting: &corev1api.ContainerStateWaiting{Reason: "ErrImageNeverPull"},
+							},
+						},
+					},
+				},
+			},
+			expected: PeekReasonImagePullBackOff,
+		},
+		{
+			name: "container is OOM killed",
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{NodeName: "fake-node"},
+				Status: corev1api.PodStatus{
+					Phase: corev1api.PodFailed,
+					ContainerStatuses: []corev1api.ContainerStatus{
+						{
+							State: corev1api.ContainerState{
+								Terminated: &corev1api.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+							},
+						},
+					},
+				},
+			},
+			expected: PeekReasonOOMKilled,
+		},
+		{
+			name: "container restarted after OOM killed",
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{NodeName: "fake-node"},
+				Status: corev1api.PodStatus{
+					Phase: corev1api.PodRunning,
+					ContainerStatuses: []corev1api.ContainerStatus{
+						{
+							RestartCount: 4,
+							LastTerminationState: corev1api.ContainerState{
+								Terminated: &corev1api.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+							},
+						},
+					},
+				},
+			},
+			expected: PeekReasonOOMKilled,
+		},
+		{
+			name: "pod failed without being scheduled",
+			pod: &corev1api.Pod{
+				Status: corev1api.PodStatus{
+					Phase:  corev1api.PodFailed,
+					Reason: "DeadlineExceeded",
+					Conditions: []corev1api.PodCondition{
+						{
+							Type:   corev1api.PodScheduled,
+							Status: corev1api.ConditionFalse,
+							Reason: corev1api.PodReasonUnschedulable,
+						},
+					},
+				},
+			},
+			expected: PeekReasonUnschedulable,
+		},
+		{
+			name: "container exits with error",
+			pod: &corev1api.Pod{
+				Spec: corev1api.PodSpec{NodeName: "fake-node"},
+				Status: corev1api.PodStatus{
+					Phase: corev1api.PodFailed,
+					ContainerStatuses: []corev1api.ContainerStatus{
+						{
+							State: corev1api.ContainerState{
+								Terminated: &corev1api.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+							},
+						},
+					},
+				},
+			},
+			expected: PeekReasonContainerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, getPeekReason(test.pod))
+		})
+	}
+}
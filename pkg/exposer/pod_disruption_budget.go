@@ -0,0 +1,78 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	policyv1api "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// podDisruptionBudgetLabel labels the backup pod with its expose name, so that the PodDisruptionBudget of the owner
+// selects the backup pod only, instead of all the backup pods of the pod group
+const podDisruptionBudgetLabel = "velero.io/exposer-pod-name"
+
+// createBackupPodDisruptionBudget creates a PodDisruptionBudget blocking the voluntary disruption of the backup pod.
+// The backup pod is still evictable once it is unhealthy, so a pod that never gets ready doesn't block node drains
+func (e *csiSnapshotExposer) createBackupPodDisruptionBudget(ctx context.Context, ownerObject corev1api.ObjectReference, namespace string, exposeName string) error {
+	maxUnavailable := intstr.FromInt32(0)
+	alwaysAllow := policyv1api.AlwaysAllow
+
+	pdb := &policyv1api.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ownerObject.Name,
+			Namespace:       namespace,
+			OwnerReferences: getExposeOwnerReferences(ownerObject, namespace),
+			Labels: labels.Merge(map[string]string{
+				podGroupLabel: podGroupSnapshot,
+			}, getCrossNamespaceOwnerLabels(ownerObject, namespace)),
+		},
+		Spec: policyv1api.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					podGroupLabel:            podGroupSnapshot,
+					podDisruptionBudgetLabel: exposeName,
+				},
+			},
+			UnhealthyPodEvictionPolicy: &alwaysAllow,
+		},
+	}
+
+	_, err := e.kubeClient.PolicyV1().PodDisruptionBudgets(namespace).Create(ctx, pdb, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "error to create pod disruption budget %s", ownerObject.Name)
+	}
+
+	return nil
+}
+
+// deleteBackupPodDisruptionBudget deletes the PodDisruptionBudget of the backup pod if it exists
+func (e *csiSnapshotExposer) deleteBackupPodDisruptionBudget(ctx context.Context, namespace string, name string) error {
+	err := e.kubeClient.PolicyV1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error to delete pod disruption budget %s/%s", namespace, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	policyv1api "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func TestCreateBackupPodDisruptionBudget(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	existingPDB := &policyv1api.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		kubeClientObj []runtime.Object
+		kubeReactors  []reactor
+		expectedErr   string
+		expectCreated bool
+	}{
+		{
+			name:          "succeed",
+			kubeClientObj: []runtime.Object{daemonSet},
+			expectCreated: true,
+		},
+		{
+			name:          "pdb already exists",
+			kubeClientObj: []runtime.Object{daemonSet, existingPDB},
+		},
+		{
+			name:          "create pdb fail",
+			kubeClientObj: []runtime.Object{daemonSet},
+			kubeReactors: []reactor{
+				{
+					verb:     "create",
+					resource: "poddisruptionbudgets",
+					reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+						return true, nil, errors.New("fake-create-error")
+					},
+				},
+			},
+			expectedErr: "error to create pod disruption budget fake-backup: fake-create-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(test.kubeClientObj...)
+			for _, reactor := range test.kubeReactors {
+				fakeKubeClient.Fake.PrependReactor(reactor.verb, reactor.resource, reactor.reactorFunc)
+			}
+
+			exposer := csiSnapshotExposer{
+				kubeClient:          fakeKubeClient,
+				log:                 velerotest.NewLogger(),
+				podDisruptionBudget: true,
+			}
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout:  time.Second,
+				PodActiveDeadline: time.Hour,
+			}
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+			require.NoError(t, err)
+			assert.Equal(t, string(ownerObject.UID), pod.Labels[podDisruptionBudgetLabel])
+
+			err = exposer.createBackupPodDisruptionBudget(context.Background(), ownerObject, ownerObject.Namespace, string(ownerObject.UID))
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			if !test.expectCreated {
+				return
+			}
+
+			pdb, err := fakeKubeClient.PolicyV1().PodDisruptionBudgets(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+
+			assert.Equal(t, int32(0), pdb.Spec.MaxUnavailable.IntVal)
+			assert.Equal(t, policyv1api.AlwaysAllow, *pdb.Spec.UnhealthyPodEvictionPolicy)
+			require.Len(t, pdb.OwnerReferences, 1)
+			assert.Equal(t, ownerObject.UID, pdb.OwnerReferences[0].UID)
+
+			selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+			require.NoError(t, err)
+
+			// the pdb only selects the backup pod of its owner
+			assert.True(t, selector.Matches(labels.Set(pod.Labels)))
+			assert.False(t, selector.Matches(labels.Set{podGroupLabel: podGroupSnapshot, podDisruptionBudgetLabel: "other-uid"}))
+			assert.False(t, selector.Matches(labels.Set{podGroupLabel: podGroupSnapshot}))
+		})
+	}
+}
+
+func TestPodDisruptionBudgetRequiresActiveDeadline(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	exposer := csiSnapshotExposer{
+		kubeClient:          fake.NewSimpleClientset(),
+		csiSnapshotClient:   snapshotFake.NewSimpleClientset().SnapshotV1(),
+		log:                 velerotest.NewLogger(),
+		podDisruptionBudget: true,
+	}
+
+	_, err := exposer.validateExpose(context.Background(), ownerObject, &CSISnapshotExposeParam{}, velerotest.NewLogger())
+	require.EqualError(t, err, "pod disruption budget requires an active deadline of the backup pod")
+	assert.ErrorIs(t, err, ErrInvalidExposeParam)
+
+	_, err = exposer.validateExpose(context.Background(), ownerObject, &CSISnapshotExposeParam{PodActiveDeadline: time.Hour}, velerotest.NewLogger())
+	require.NoError(t, err)
+}
+
+func TestCleanUpPodDisruptionBudget(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	pdb := func() *policyv1api.PodDisruptionBudget {
+		return &policyv1api.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ownerObject.Namespace,
+				Name:      ownerObject.Name,
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		enabled         bool
+		expectedDeleted bool
+	}{
+		{
+			name:            "pdb is deleted",
+			enabled:         true,
+			expectedDeleted: true,
+		},
+		{
+			name: "pdb is not touched when not enabled",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeKubeClient := fake.NewSimpleClientset(pdb())
+
+			opts := []CSISnapshotExposerOption{WithCleanUpConcurrency(false)}
+			if test.enabled {
+				opts = append(opts, WithPodDisruptionBudget())
+			}
+
+			exposer := NewCSISnapshotExposer(fakeKubeClient, snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), opts...)
+			exposer.CleanUp(context.Background(), ownerObject, "fake-vs", "fake-ns")
+
+			_, err := fakeKubeClient.PolicyV1().PodDisruptionBudgets(ownerObject.Namespace).Get(context.Background(), ownerObject.Name, metav1.GetOptions{})
+			if test.expectedDeleted {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("pdb doesn't exist", func(t *testing.T) {
+		exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), WithPodDisruptionBudget())
+		exposer.CleanUp(context.Background(), ownerObject, "fake-vs", "fake-ns")
+	})
+}
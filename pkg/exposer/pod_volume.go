@@ -80,7 +80,7 @@ type PodVolumeExposer interface {
 	GetExposed(context.Context, corev1api.ObjectReference, client.Client, string, time.Duration) (*ExposeResult, error)
 
 	// PeekExposed tests the status of the expose.
-	// If the expose is incomplete but not recoverable, it returns an error.
+	// If the expose is incomplete but not recoverable, it returns an error, which is a *PeekError if the hosting pod is unrecoverable.
 	// Otherwise, it returns nil immediately.
 	PeekExposed(context.Context, corev1api.ObjectReference) error
 
@@ -225,7 +225,7 @@ func (e *podVolumeExposer) PeekExposed(ctx context.Context, ownerObject corev1ap
 	}
 
 	if podFailed, message := kube.IsPodUnrecoverable(pod, curLog); podFailed {
-		return errors.New(message)
+		return newPeekError(pod, message)
 	}
 
 	return nil
@@ -33,7 +33,7 @@ type SnapshotExposer interface {
 	GetExposed(context.Context, corev1api.ObjectReference, time.Duration, any) (*ExposeResult, error)
 
 	// PeekExposed tests the status of the expose.
-	// If the expose is incomplete but not recoverable, it returns an error.
+	// If the expose is incomplete but not recoverable, it returns an error, which is a *PeekError if the hosting pod is unrecoverable.
 	// Otherwise, it returns nil immediately.
 	PeekExposed(context.Context, corev1api.ObjectReference) error
 
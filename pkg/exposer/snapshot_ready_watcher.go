@@ -0,0 +1,205 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	snapshotter "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/typed/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/csi"
+	"github.com/vmware-tanzu/velero/pkg/util/stringptr"
+)
+
+// snapshotInformerSyncTimeout is the time to wait for the snapshot informer to sync, after which the readiness is polled
+var snapshotInformerSyncTimeout = 5 * time.Second
+
+// snapshotReadyWatcher detects the readiness of VolumeSnapshots from the events of a shared informer,
+// so that the concurrent exposes don't poll the API server for each snapshot.
+// It falls back to polling if the informer is not synced or is stopped
+type snapshotReadyWatcher struct {
+	client   snapshotter.SnapshotV1Interface
+	informer cache.SharedIndexInformer
+	stopCh   <-chan struct{}
+	start    sync.Once
+
+	lock    sync.Mutex
+	waiters map[string]map[chan struct{}]struct{}
+}
+
+func newSnapshotReadyWatcher(ctx context.Context, client snapshotter.SnapshotV1Interface) *snapshotReadyWatcher {
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return client.VolumeSnapshots(metav1.NamespaceAll).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return client.VolumeSnapshots(metav1.NamespaceAll).Watch(ctx, options)
+		},
+	}, &snapshotv1api.VolumeSnapshot{}, 0, cache.Indexers{})
+
+	w := &snapshotReadyWatcher{
+		client:   client,
+		informer: informer,
+		stopCh:   ctx.Done(),
+		waiters:  map[string]map[chan struct{}]struct{}{},
+	}
+
+	// the registration is never removed since the informer lives as long as the watcher
+	_, _ = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: w.notify,
+		UpdateFunc: func(_, obj any) {
+			w.notify(obj)
+		},
+	})
+
+	return w
+}
+
+// notify wakes up the waiters of the snapshot once it is ready
+func (w *snapshotReadyWatcher) notify(obj any) {
+	vs, ok := obj.(*snapshotv1api.VolumeSnapshot)
+	if !ok || !isVolumeSnapshotReady(vs) {
+		return
+	}
+
+	key := vs.Namespace + "/" + vs.Name
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for ch := range w.waiters[key] {
+		close(ch)
+	}
+
+	delete(w.waiters, key)
+}
+
+func (w *snapshotReadyWatcher) addWaiter(key string) chan struct{} {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	ch := make(chan struct{})
+	if w.waiters[key] == nil {
+		w.waiters[key] = map[chan struct{}]struct{}{}
+	}
+
+	w.waiters[key][ch] = struct{}{}
+
+	return ch
+}
+
+func (w *snapshotReadyWatcher) removeWaiter(key string, ch chan struct{}) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	delete(w.waiters[key], ch)
+	if len(w.waiters[key]) == 0 {
+		delete(w.waiters, key)
+	}
+}
+
+// synced starts the informer if it is not started and waits for it to sync
+func (w *snapshotReadyWatcher) synced(ctx context.Context) bool {
+	w.start.Do(func() {
+		go w.informer.Run(w.stopCh)
+	})
+
+	select {
+	case <-w.stopCh:
+		return false
+	default:
+	}
+
+	syncCtx, cancel := context.WithTimeout(ctx, snapshotInformerSyncTimeout)
+	defer cancel()
+
+	return cache.WaitForCacheSync(syncCtx.Done(), w.informer.HasSynced)
+}
+
+// waitReady waits the snapshot to be ready to use by the informer events, it polls the snapshot if the informer is not available
+func (w *snapshotReadyWatcher) waitReady(ctx context.Context, name string, namespace string, timeout time.Duration, log logrus.FieldLogger) (*snapshotv1api.VolumeSnapshot, error) {
+	if !w.synced(ctx) {
+		log.Warnf("Snapshot informer is not synced, poll the readiness of VolumeSnapshot %s/%s", namespace, name)
+		return csi.WaitVolumeSnapshotReady(ctx, w.client, name, namespace, timeout, log)
+	}
+
+	key := namespace + "/" + name
+
+	// add the waiter before checking the cache, so that an event between them is not missed
+	ch := w.addWaiter(key)
+	defer func() {
+		w.removeWaiter(key, ch)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		obj, exists, err := w.informer.GetStore().GetByKey(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error to get VolumeSnapshot %s/%s from cache", namespace, name)
+		}
+
+		var vs *snapshotv1api.VolumeSnapshot
+		if exists {
+			vs = obj.(*snapshotv1api.VolumeSnapshot)
+			if isVolumeSnapshotReady(vs) {
+				return vs.DeepCopy(), nil
+			}
+
+			if err := csi.GetVolumeSnapshotFailure(vs); err != nil {
+				return nil, err
+			}
+		}
+
+		select {
+		case <-ch:
+			// the notifier removes the waiter once it is woken up, add a new one before checking the cache again
+			ch = w.addWaiter(key)
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "error to wait VolumeSnapshot %s/%s ready", namespace, name)
+		case <-w.stopCh:
+			log.Warnf("Snapshot informer is stopped, poll the readiness of VolumeSnapshot %s/%s", namespace, name)
+			return csi.WaitVolumeSnapshotReady(ctx, w.client, name, namespace, timeout, log)
+		case <-timer.C:
+			if !exists {
+				return nil, errors.Errorf("VolumeSnapshot %s/%s is not found until timeout", namespace, name)
+			}
+
+			errMessage := []string{}
+			if vs.Status != nil && vs.Status.Error != nil {
+				errMessage = append(errMessage, stringptr.GetString(vs.Status.Error.Message))
+			}
+
+			return nil, errors.Errorf("volume snapshot is not ready until timeout, errors: %v", errMessage)
+		}
+	}
+}
+
+func isVolumeSnapshotReady(vs *snapshotv1api.VolumeSnapshot) bool {
+	return vs.Status != nil && boolptr.IsSetToTrue(vs.Status.ReadyToUse)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v7/apis/volumesnapshot/v1"
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientTesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func newWatcherTestVS(ready bool) *snapshotv1api.VolumeSnapshot {
+	vs := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-ns",
+			Name:      "fake-vs",
+		},
+	}
+
+	if ready {
+		vs.Status = &snapshotv1api.VolumeSnapshotStatus{
+			ReadyToUse: boolptr.True(),
+		}
+	}
+
+	return vs
+}
+
+func countActions(client *snapshotFake.Clientset, verb string) int {
+	count := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == verb {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestSnapshotReadyWatcherInformerEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := snapshotFake.NewSimpleClientset(newWatcherTestVS(false))
+	watcher := newSnapshotReadyWatcher(ctx, client.SnapshotV1())
+
+	type waitResult struct {
+		vs  *snapshotv1api.VolumeSnapshot
+		err error
+	}
+
+	// two concurrent exposes wait for the same snapshot
+	results := make(chan waitResult, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			vs, err := watcher.waitReady(ctx, "fake-vs", "fake-ns", time.Minute, velerotest.NewLogger())
+			results <- waitResult{vs, err}
+		}()
+	}
+
+	// make sure both waiters are registered before the snapshot turns ready
+	require.Eventually(t, func() bool {
+		watcher.lock.Lock()
+		defer watcher.lock.Unlock()
+
+		return len(watcher.waiters["fake-ns/fake-vs"]) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err := client.SnapshotV1().VolumeSnapshots("fake-ns").UpdateStatus(ctx, newWatcherTestVS(true), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case result := <-results:
+			require.NoError(t, result.err)
+			assert.True(t, boolptr.IsSetToTrue(result.vs.Status.ReadyToUse))
+		case <-time.After(10 * time.Second):
+			t.Fatal("readiness is not detected by the informer event")
+		}
+	}
+
+	// the readiness is detected without polling the snapshot
+	assert.Equal(t, 0, countActions(client, "get"))
+	assert.Equal(t, 1, countActions(client, "list"))
+
+	watcher.lock.Lock()
+	assert.Empty(t, watcher.waiters)
+	watcher.lock.Unlock()
+}
+
+func TestSnapshotReadyWatcherWaitReady(t *testing.T) {
+	tests := []struct {
+		name            string
+		snapshotObj     []runtime.Object
+		snapshotReactor *reactor
+		stopped         bool
+		timeout         time.Duration
+		expectedErr     string
+		expectedGets    int
+	}{
+		{
+			name:        "ready in cache",
+			snapshotObj: []runtime.Object{newWatcherTestVS(true)},
+			timeout:     time.Minute,
+		},
+		{
+			name:        "not ready until timeout",
+			snapshotObj: []runtime.Object{newWatcherTestVS(false)},
+			timeout:     100 * time.Millisecond,
+			expectedErr: "volume snapshot is not ready until timeout, errors: []",
+		},
+		{
+			name: "permanent error in cache",
+			snapshotObj: []runtime.Object{
+				&snapshotv1api.VolumeSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-ns",
+						Name:      "fake-vs",
+					},
+					Status: &snapshotv1api.VolumeSnapshotStatus{
+						Error: &snapshotv1api.VolumeSnapshotError{
+							Message: pointer.String("fake-snapshot-error"),
+						},
+					},
+				},
+			},
+			timeout:     time.Minute,
+			expectedErr: "VolumeSnapshot fake-ns/fake-vs failed: fake-snapshot-error",
+		},
+		{
+			name:        "not found until timeout",
+			timeout:     100 * time.Millisecond,
+			expectedErr: "VolumeSnapshot fake-ns/fake-vs is not found until timeout",
+		},
+		{
+			name:        "informer is not synced, fall back to polling",
+			snapshotObj: []runtime.Object{newWatcherTestVS(true)},
+			snapshotReactor: &reactor{
+				verb:     "list",
+				resource: "volumesnapshots",
+				reactorFunc: func(action clientTesting.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, errors.New("fake-list-error")
+				},
+			},
+			timeout:      time.Minute,
+			expectedGets: 1,
+		},
+		{
+			name:         "informer is stopped, fall back to polling",
+			snapshotObj:  []runtime.Object{newWatcherTestVS(true)},
+			stopped:      true,
+			timeout:      time.Minute,
+			expectedGets: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			syncTimeout := snapshotInformerSyncTimeout
+			snapshotInformerSyncTimeout = 200 * time.Millisecond
+			defer func() {
+				snapshotInformerSyncTimeout = syncTimeout
+			}()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			client := snapshotFake.NewSimpleClientset(test.snapshotObj...)
+			if test.snapshotReactor != nil {
+				client.Fake.PrependReactor(test.snapshotReactor.verb, test.snapshotReactor.resource, test.snapshotReactor.reactorFunc)
+			}
+
+			watcherCtx, stop := context.WithCancel(ctx)
+			watcher := newSnapshotReadyWatcher(watcherCtx, client.SnapshotV1())
+			if test.stopped {
+				stop()
+			} else {
+				defer stop()
+			}
+
+			vs, err := watcher.waitReady(ctx, "fake-vs", "fake-ns", test.timeout, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, boolptr.IsSetToTrue(vs.Status.ReadyToUse))
+			}
+
+			assert.Equal(t, test.expectedGets, countActions(client, "get"))
+		})
+	}
+}
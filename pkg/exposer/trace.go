@@ -0,0 +1,55 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	corev1api "k8s.io/api/core/v1"
+)
+
+const (
+	tracerName = "github.com/vmware-tanzu/velero/pkg/exposer"
+
+	traceAttrOwner    = "velero.owner"
+	traceAttrSnapshot = "velero.snapshot"
+	traceAttrNode     = "velero.node"
+	traceAttrNodeOS   = "velero.node_os"
+)
+
+// startSpan starts a span as a child of the span in the context.
+// The tracer is got from the span in the context, so it is a no-op if the caller doesn't trace.
+func startSpan(ctx context.Context, name string, ownerObject corev1api.ObjectReference, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	tracer := trace.SpanFromContext(ctx).TracerProvider().Tracer(tracerName)
+
+	attrs = append([]attribute.KeyValue{attribute.String(traceAttrOwner, ownerObject.Namespace+"/"+ownerObject.Name)}, attrs...)
+
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error if any and ends the span
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,171 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func TestExposeTrace(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "DataUpload",
+		Namespace: "velero",
+		Name:      "fake-du",
+		UID:       "fake-uid",
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	exposer := csiSnapshotExposer{
+		kubeClient:        fake.NewSimpleClientset(),
+		csiSnapshotClient: snapshotFake.NewSimpleClientset().SnapshotV1(),
+		log:               velerotest.NewLogger(),
+	}
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+
+	err := exposer.Expose(ctx, ownerObject, &CSISnapshotExposeParam{
+		SnapshotName:     "fake-vs",
+		SourceNamespace:  "fake-ns",
+		NodeOS:           kube.NodeOSLinux,
+		OperationTimeout: time.Millisecond,
+		ExposeTimeout:    time.Millisecond,
+	})
+	require.Error(t, err)
+
+	exposer.CleanUp(ctx, ownerObject, "fake-vs", "fake-ns")
+
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	assert.Equal(t, "CSISnapshotExposer.Expose", spans[0].Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Contains(t, spans[0].Attributes, attribute.String(traceAttrOwner, "velero/fake-du"))
+	assert.Contains(t, spans[0].Attributes, attribute.String(traceAttrSnapshot, "fake-ns/fake-vs"))
+
+	assert.Equal(t, "CSISnapshotExposer.CleanUp", spans[1].Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[1].Parent.SpanID())
+	assert.Equal(t, codes.Unset, spans[1].Status.Code)
+	assert.Contains(t, spans[1].Attributes, attribute.String(traceAttrOwner, "velero/fake-du"))
+
+	assert.Equal(t, "parent", spans[2].Name)
+}
+
+func TestGetExposedTrace(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "DataUpload",
+		Namespace: "velero",
+		Name:      "fake-du",
+		UID:       "fake-uid",
+	}
+
+	backupPod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: "fake-node",
+			NodeSelector: map[string]string{
+				kube.NodeOSLabel: kube.NodeOSLinux,
+			},
+			Volumes: []corev1api.Volume{
+				{
+					Name: string(ownerObject.UID),
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			VolumeName: "fake-pv",
+		},
+	}
+
+	backupPV := &corev1api.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-pv",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	corev1api.AddToScheme(scheme)
+	fakeClient := clientFake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(backupPod).Build()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	fakeKubeClient := fake.NewSimpleClientset(backupPod, backupPVC, backupPV)
+	exposer := csiSnapshotExposer{
+		kubeClient: fakeKubeClient,
+		log:        velerotest.NewLogger(),
+	}
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+
+	result, err := exposer.GetExposed(ctx, ownerObject, time.Second, &CSISnapshotExposeWaitParam{
+		NodeClient: fakeClient,
+		NodeName:   "fake-node",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "CSISnapshotExposer.GetExposed", spans[0].Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	assert.Contains(t, spans[0].Attributes, attribute.String(traceAttrOwner, "velero/fake-du"))
+	assert.Contains(t, spans[0].Attributes, attribute.String(traceAttrNode, "fake-node"))
+	assert.Contains(t, spans[0].Attributes, attribute.String(traceAttrNodeOS, kube.NodeOSLinux))
+}
+
+func TestStartSpanWithoutTracer(t *testing.T) {
+	_, span := startSpan(context.Background(), "fake-span", corev1api.ObjectReference{})
+	assert.False(t, span.SpanContext().IsValid())
+	assert.False(t, span.IsRecording())
+}
@@ -21,11 +21,57 @@ import (
 )
 
 const (
-	AccessModeFileSystem   = "by-file-system"
-	AccessModeBlock        = "by-block-device"
-	podGroupLabel          = "velero.io/exposer-pod-group"
-	podGroupSnapshot       = "snapshot-exposer"
-	podGroupGenericRestore = "generic-restore-exposer"
+	AccessModeFileSystem      = "by-file-system"
+	AccessModeBlock           = "by-block-device"
+	podGroupLabel             = "velero.io/exposer-pod-group"
+	podGroupSnapshot          = "snapshot-exposer"
+	podGroupGenericRestore    = "generic-restore-exposer"
+	exposeOwnerUIDLabel       = "velero.io/exposer-owner-uid"
+	exposeOwnerNamespaceLabel = "velero.io/exposer-owner-namespace"
+)
+
+// NodeAgentAffinityMode specifies how the backup pod is co-located with the node-agent pods
+type NodeAgentAffinityMode string
+
+const (
+	// NodeAgentAffinityOff doesn't generate the node-agent affinity, it is the default
+	NodeAgentAffinityOff NodeAgentAffinityMode = "Off"
+
+	// NodeAgentAffinityRequired requires the backup pod to be scheduled to a node running node-agent,
+	// so the backup pod stays pending if there is no such node
+	NodeAgentAffinityRequired NodeAgentAffinityMode = "Required"
+
+	// NodeAgentAffinityPreferred prefers the nodes running node-agent for the backup pod
+	NodeAgentAffinityPreferred NodeAgentAffinityMode = "Preferred"
+)
+
+// ExposeDirection specifies the direction of the data movement the exposed volume is used for
+type ExposeDirection string
+
+const (
+	// ExposeDirectionBackup runs the data mover backup from the exposed volume, it is the default
+	ExposeDirectionBackup ExposeDirection = "Backup"
+
+	// ExposeDirectionRestore runs the data mover restore to the exposed volume
+	ExposeDirectionRestore ExposeDirection = "Restore"
+)
+
+const (
+	EventReasonSnapshotReady            = "Expose-Snapshot-Ready"
+	EventReasonBackupVSCreated          = "Expose-Backup-VS-Created"
+	EventReasonBackupPVCBound           = "Expose-Backup-PVC-Bound"
+	EventReasonExposeFailed             = "Expose-Failed"
+	EventReasonGetExposedFailed         = "Expose-Get-Exposed-Failed"
+	EventReasonCleanedUp                = "Expose-Cleaned-Up"
+	EventReasonRuntimeClassIncompatible = "Expose-Runtime-Class-Incompatible"
+)
+
+// The env vars injected into the backup container with the snapshot metadata
+const (
+	EnvSnapshotDriver  = "VELERO_SNAPSHOT_DRIVER"
+	EnvSnapshotClass   = "VELERO_SNAPSHOT_CLASS"
+	EnvRestoreSize     = "VELERO_RESTORE_SIZE"
+	EnvSourceNamespace = "VELERO_SOURCE_NAMESPACE"
 )
 
 // ExposeResult defines the result of expose.
@@ -40,4 +86,89 @@ type ExposeByPod struct {
 	HostingContainer string
 	VolumeName       string
 	NodeOS           *string
+	Scheduling       *ExposeScheduling
+
+	// Volumes lists all the exposed volumes in the hosting pod, e.g., for the movers bundling multiple volumes in one pod.
+	// The first one is the same as HostingContainer and VolumeName. It is empty for the exposers only exposing a single volume
+	Volumes []ExposeVolume
+}
+
+// ExposeVolume defines an exposed volume in the hosting pod and the container mounting it
+type ExposeVolume struct {
+	VolumeName    string
+	ContainerName string
+}
+
+// ExposeScheduling defines the effective scheduling settings that the hosting pod is created with,
+// after the node OS selector, the default tolerations and the load affinities are merged
+type ExposeScheduling struct {
+	NodeSelector              map[string]string
+	Tolerations               []corev1api.Toleration
+	Affinity                  *corev1api.Affinity
+	TopologySpreadConstraints []corev1api.TopologySpreadConstraint
+}
+
+func getExposeScheduling(pod *corev1api.Pod) *ExposeScheduling {
+	return &ExposeScheduling{
+		NodeSelector:              pod.Spec.NodeSelector,
+		Tolerations:               pod.Spec.Tolerations,
+		Affinity:                  pod.Spec.Affinity,
+		TopologySpreadConstraints: pod.Spec.TopologySpreadConstraints,
+	}
+}
+
+// ExposePhase is the phase of an in-progress expose
+type ExposePhase string
+
+const (
+	// ExposePhasePodPending means the hosting pod is not scheduled to any node yet
+	ExposePhasePodPending ExposePhase = "PodPending"
+	// ExposePhasePVCBinding means the hosting pod is scheduled but the volume is not bound yet
+	ExposePhasePVCBinding ExposePhase = "PVCBinding"
+	// ExposePhaseBound means the volume is bound but the hosting pod is not running yet
+	ExposePhaseBound ExposePhase = "Bound"
+	// ExposePhasePodRunning means the hosting pod is running with the bound volume, the expose is ready
+	ExposePhasePodRunning ExposePhase = "PodRunning"
+)
+
+// exposePhasePercentage is the rough progress percentage of each expose phase
+var exposePhasePercentage = map[ExposePhase]int{
+	ExposePhasePodPending: 25,
+	ExposePhasePVCBinding: 50,
+	ExposePhaseBound:      75,
+	ExposePhasePodRunning: 100,
+}
+
+// ExposeProgress defines the progress of an in-progress expose
+type ExposeProgress struct {
+	Phase      ExposePhase
+	Percentage int
+	NodeName   string
+	VolumeName string
+}
+
+// getExposeProgress derives the expose progress from the current state of the hosting pod and the PVC
+func getExposeProgress(pod *corev1api.Pod, pvc *corev1api.PersistentVolumeClaim) *ExposeProgress {
+	progress := &ExposeProgress{
+		NodeName: pod.Spec.NodeName,
+	}
+
+	if pvc.Status.Phase == corev1api.ClaimBound {
+		progress.VolumeName = pvc.Spec.VolumeName
+	}
+
+	switch {
+	case pod.Spec.NodeName == "" && progress.VolumeName == "":
+		progress.Phase = ExposePhasePodPending
+	case progress.VolumeName == "":
+		progress.Phase = ExposePhasePVCBinding
+	case pod.Status.Phase != corev1api.PodRunning:
+		progress.Phase = ExposePhaseBound
+	default:
+		progress.Phase = ExposePhasePodRunning
+	}
+
+	progress.Percentage = exposePhasePercentage[progress.Phase]
+
+	return progress
 }
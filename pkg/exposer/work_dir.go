@@ -0,0 +1,100 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+)
+
+// WorkDirMountPath is the path in the backup container where the per-owner working directory on the node is mounted
+const WorkDirMountPath = "/velero-work-dir"
+
+const workDirVolumeName = "velero-work-dir"
+
+// protectedHostPaths are the system directories of the node, the base path of the working directories can't be or be under any of them
+var protectedHostPaths = []string{
+	"/bin",
+	"/boot",
+	"/dev",
+	"/etc",
+	"/lib",
+	"/proc",
+	"/root",
+	"/sbin",
+	"/sys",
+	"/usr",
+	"/var/lib/kubelet",
+	"/var/run",
+}
+
+// validateWorkDirBasePath checks the base path of the working directories is a clean absolute path other than the root and the system directories
+func validateWorkDirBasePath(basePath string) error {
+	if !path.IsAbs(basePath) || path.Clean(basePath) != basePath {
+		return errors.Errorf("base path %s of working directory is not a clean absolute path", basePath)
+	}
+
+	if basePath == "/" {
+		return errors.New("base path of working directory can't be the root directory")
+	}
+
+	for _, protected := range protectedHostPaths {
+		if basePath == protected || strings.HasPrefix(basePath, protected+"/") {
+			return errors.Errorf("base path %s of working directory is under the system directory %s", basePath, protected)
+		}
+	}
+
+	return nil
+}
+
+// workDirHostPath returns the working directory of the owner on the node, which is scoped by the owner's UID
+func workDirHostPath(basePath string, ownerObject corev1api.ObjectReference) string {
+	return path.Join(basePath, string(ownerObject.UID))
+}
+
+// workDirVolume returns the hostPath volume of the owner's working directory and its mount in the backup container.
+// The directory is created by kubelet on the node of the backup pod if it doesn't exist
+func workDirVolume(basePath string, ownerObject corev1api.ObjectReference) (corev1api.Volume, corev1api.VolumeMount) {
+	hostPathType := corev1api.HostPathDirectoryOrCreate
+
+	return corev1api.Volume{
+		Name: workDirVolumeName,
+		VolumeSource: corev1api.VolumeSource{
+			HostPath: &corev1api.HostPathVolumeSource{
+				Path: workDirHostPath(basePath, ownerObject),
+				Type: &hostPathType,
+			},
+		},
+	}, corev1api.VolumeMount{
+		Name:      workDirVolumeName,
+		MountPath: WorkDirMountPath,
+	}
+}
+
+// removeWorkDir removes the working directory of the owner with its contents through the file system of the exposer,
+// so the base path must be mounted at the same path, e.g., into node-agent running on the node of the backup pod
+func (e *csiSnapshotExposer) removeWorkDir(ownerObject corev1api.ObjectReference) error {
+	workDir := workDirHostPath(e.workDirBasePath, ownerObject)
+	if err := e.workDirFS.RemoveAll(workDir); err != nil {
+		return errors.Wrapf(err, "error to remove working directory %s", workDir)
+	}
+
+	return nil
+}
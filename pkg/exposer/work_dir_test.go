@@ -0,0 +1,207 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotFake "github.com/kubernetes-csi/external-snapshotter/client/v7/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+func TestValidateWorkDirBasePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		basePath string
+		err      string
+	}{
+		{
+			name:     "valid",
+			basePath: "/var/lib/velero/work",
+		},
+		{
+			name:     "relative path",
+			basePath: "var/lib/velero",
+			err:      "base path var/lib/velero of working directory is not a clean absolute path",
+		},
+		{
+			name:     "unclean path",
+			basePath: "/var/lib/velero/../kubelet",
+			err:      "base path /var/lib/velero/../kubelet of working directory is not a clean absolute path",
+		},
+		{
+			name:     "root",
+			basePath: "/",
+			err:      "base path of working directory can't be the root directory",
+		},
+		{
+			name:     "system directory",
+			basePath: "/etc",
+			err:      "base path /etc of working directory is under the system directory /etc",
+		},
+		{
+			name:     "under system directory",
+			basePath: "/var/lib/kubelet/pods",
+			err:      "base path /var/lib/kubelet/pods of working directory is under the system directory /var/lib/kubelet",
+		},
+		{
+			name:     "prefix of system directory is not protected",
+			basePath: "/etcd-work",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateWorkDirBasePath(test.basePath)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestMountWorkDir(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:       "Backup",
+		Namespace:  velerov1.DefaultNamespace,
+		Name:       "fake-backup",
+		UID:        "fake-uid",
+		APIVersion: velerov1.SchemeGroupVersion.String(),
+	}
+
+	daemonSet := &appsv1api.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "node-agent",
+		},
+		Spec: appsv1api.DaemonSetSpec{
+			Template: corev1api.PodTemplateSpec{
+				Spec: corev1api.PodSpec{
+					Containers: []corev1api.Container{
+						{
+							Name: "node-agent",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backupPVC := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ownerObject.Namespace,
+			Name:      ownerObject.Name,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     []CSISnapshotExposerOption
+		err      string
+		expected string
+	}{
+		{
+			name: "not allowed",
+			err:  "working directory on the node is not allowed by the exposer",
+		},
+		{
+			name: "invalid base path",
+			opts: []CSISnapshotExposerOption{WithHostPathWorkDir("/proc/velero", velerotest.NewFakeFileSystem())},
+			err:  "base path /proc/velero of working directory is under the system directory /proc",
+		},
+		{
+			name:     "mounted",
+			opts:     []CSISnapshotExposerOption{WithHostPathWorkDir("/var/lib/velero/work", velerotest.NewFakeFileSystem())},
+			expected: "/var/lib/velero/work/fake-uid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(daemonSet), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(), test.opts...).(*csiSnapshotExposer)
+
+			param := &CSISnapshotExposeParam{
+				OperationTimeout: time.Second,
+				MountWorkDir:     true,
+			}
+
+			_, err := exposer.validateExpose(context.Background(), ownerObject, param, velerotest.NewLogger())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				assert.ErrorIs(t, err, ErrInvalidExposeParam)
+				return
+			}
+
+			require.NoError(t, err)
+
+			pod, err := exposer.createBackupPod(context.Background(), ownerObject, backupPVC, param, false, false, kube.NodeOSLinux, "", nil)
+			require.NoError(t, err)
+
+			var workDir *corev1api.Volume
+			for i := range pod.Spec.Volumes {
+				if pod.Spec.Volumes[i].Name == workDirVolumeName {
+					workDir = &pod.Spec.Volumes[i]
+				}
+			}
+
+			require.NotNil(t, workDir)
+			require.NotNil(t, workDir.HostPath)
+			assert.Equal(t, test.expected, workDir.HostPath.Path)
+			assert.Equal(t, corev1api.HostPathDirectoryOrCreate, *workDir.HostPath.Type)
+			assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, corev1api.VolumeMount{Name: workDirVolumeName, MountPath: WorkDirMountPath})
+		})
+	}
+}
+
+func TestCleanUpWorkDir(t *testing.T) {
+	ownerObject := corev1api.ObjectReference{
+		Kind:      "Backup",
+		Namespace: velerov1.DefaultNamespace,
+		Name:      "fake-backup",
+		UID:       "fake-uid",
+	}
+
+	fs := velerotest.NewFakeFileSystem()
+	require.NoError(t, fs.MkdirAll("/var/lib/velero/work/fake-uid/staging", 0755))
+	require.NoError(t, fs.MkdirAll("/var/lib/velero/work/other-uid", 0755))
+
+	exposer := NewCSISnapshotExposer(fake.NewSimpleClientset(), snapshotFake.NewSimpleClientset().SnapshotV1(), velerotest.NewLogger(),
+		WithHostPathWorkDir("/var/lib/velero/work", fs))
+	exposer.CleanUp(context.Background(), ownerObject, "", "")
+
+	exists, err := fs.DirExists("/var/lib/velero/work/fake-uid")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// the working directories of other owners are kept
+	exists, err = fs.DirExists("/var/lib/velero/work/other-uid")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
@@ -86,6 +87,14 @@ type BackupPVC struct {
 	// SPCNoRelabeling sets Spec.SecurityContext.SELinux.Type to "spc_t" for the pod mounting the backupPVC
 	// ignored if ReadOnly is false
 	SPCNoRelabeling bool `json:"spcNoRelabeling,omitempty"`
+
+	// BackupPVCVolumeAttributesClass is the name of the VolumeAttributesClass of the backupPVC, e.g., a lower QoS class than the source volume.
+	// It is ignored if the API server doesn't support VolumeAttributesClass
+	BackupPVCVolumeAttributesClass *string `json:"volumeAttributesClass,omitempty"`
+
+	// MinVolumeSize is the minimum size of the volume provisioned by the storage class, e.g., 1Gi of some cloud block stores.
+	// The size of the backupPVC is rounded up to it, otherwise, the provision fails for the tiny volumes. Zero means no minimum
+	MinVolumeSize resource.Quantity `json:"minVolumeSize,omitempty"`
 }
 
 type RestorePVC struct {
@@ -84,6 +84,10 @@ func WaitVolumeSnapshotReady(
 			}
 
 			if !boolptr.IsSetToTrue(tmpVS.Status.ReadyToUse) {
+				if err := GetVolumeSnapshotFailure(tmpVS); err != nil {
+					return false, err
+				}
+
 				return false, nil
 			}
 
@@ -107,6 +111,53 @@ func WaitVolumeSnapshotReady(
 	return updated, err
 }
 
+// VolumeSnapshotFailure is the error returned when the VolumeSnapshot reports a non-transient error,
+// with which the snapshot is not going to be ready, so there is no point to wait for it any more
+type VolumeSnapshotFailure struct {
+	Namespace string
+	Name      string
+	Message   string
+}
+
+func (f *VolumeSnapshotFailure) Error() string {
+	return fmt.Sprintf("VolumeSnapshot %s/%s failed: %s", f.Namespace, f.Name, f.Message)
+}
+
+// transientSnapshotErrors are the substrings of the snapshot error messages caused by transient failures,
+// with which the snapshot controller keeps retrying
+var transientSnapshotErrors = []string{
+	"the object has been modified",
+	"context deadline exceeded",
+	"connection refused",
+	"i/o timeout",
+	"too many requests",
+	"code = Unavailable",
+	"code = DeadlineExceeded",
+	"code = Aborted",
+}
+
+// GetVolumeSnapshotFailure returns a VolumeSnapshotFailure if the VolumeSnapshot is not ready and reports a non-transient error.
+// An error without message is taken as transient, since there is nothing to tell whether it is permanent
+func GetVolumeSnapshotFailure(vs *snapshotv1api.VolumeSnapshot) error {
+	if vs.Status == nil || vs.Status.Error == nil || boolptr.IsSetToTrue(vs.Status.ReadyToUse) {
+		return nil
+	}
+
+	if vs.Status.Error.Message == nil || *vs.Status.Error.Message == "" {
+		return nil
+	}
+
+	message := *vs.Status.Error.Message
+
+	for _, transient := range transientSnapshotErrors {
+		if strings.Contains(strings.ToLower(message), strings.ToLower(transient)) {
+			return nil
+		}
+	}
+
+	return &VolumeSnapshotFailure{Namespace: vs.Namespace, Name: vs.Name, Message: message}
+}
+
 // GetVolumeSnapshotContentForVolumeSnapshot returns the VolumeSnapshotContent
 // object associated with the VolumeSnapshot.
 func GetVolumeSnapshotContentForVolumeSnapshot(
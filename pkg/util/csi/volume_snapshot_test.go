@@ -68,6 +68,7 @@ func TestWaitVolumeSnapshotReady(t *testing.T) {
 	}
 
 	errMessage := "fake-snapshot-creation-error"
+	transientErrMessage := "rpc error: code = Unavailable desc = fake-connection-error"
 
 	tests := []struct {
 		name      string
@@ -164,7 +165,26 @@ func TestWaitVolumeSnapshotReady(t *testing.T) {
 					},
 				},
 			},
-			err: "volume snapshot is not ready until timeout, errors: [fake-snapshot-creation-error]",
+			err: "VolumeSnapshot fake-ns/fake-vs failed: fake-snapshot-creation-error",
+		},
+		{
+			name:      "snapshot creation transient error",
+			vsName:    "fake-vs",
+			namespace: "fake-ns",
+			clientObj: []runtime.Object{
+				&snapshotv1api.VolumeSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-vs",
+						Namespace: "fake-ns",
+					},
+					Status: &snapshotv1api.VolumeSnapshotStatus{
+						Error: &snapshotv1api.VolumeSnapshotError{
+							Message: &transientErrMessage,
+						},
+					},
+				},
+			},
+			err: "volume snapshot is not ready until timeout, errors: [" + transientErrMessage + "]",
 		},
 		{
 			name:      "snapshot creation error without message",
@@ -210,6 +230,84 @@ func TestWaitVolumeSnapshotReady(t *testing.T) {
 	}
 }
 
+func TestGetVolumeSnapshotFailure(t *testing.T) {
+	vsWithError := func(message *string, ready *bool) *snapshotv1api.VolumeSnapshot {
+		return &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-vs",
+				Namespace: "fake-ns",
+			},
+			Status: &snapshotv1api.VolumeSnapshotStatus{
+				ReadyToUse: ready,
+				Error: &snapshotv1api.VolumeSnapshotError{
+					Message: message,
+				},
+			},
+		}
+	}
+
+	stringPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name        string
+		vs          *snapshotv1api.VolumeSnapshot
+		expectedErr string
+	}{
+		{
+			name: "no status",
+			vs:   &snapshotv1api.VolumeSnapshot{},
+		},
+		{
+			name: "no error",
+			vs: &snapshotv1api.VolumeSnapshot{
+				Status: &snapshotv1api.VolumeSnapshotStatus{},
+			},
+		},
+		{
+			name: "error without message",
+			vs:   vsWithError(nil, nil),
+		},
+		{
+			name: "error with empty message",
+			vs:   vsWithError(stringPtr(""), nil),
+		},
+		{
+			name: "error of ready snapshot",
+			vs:   vsWithError(stringPtr("fake-error"), boolptr.True()),
+		},
+		{
+			name: "conflict error",
+			vs:   vsWithError(stringPtr("Operation cannot be fulfilled: the object has been modified; please apply your changes to the latest version and try again"), nil),
+		},
+		{
+			name: "unavailable error",
+			vs:   vsWithError(stringPtr("rpc error: code = Unavailable desc = connection error"), boolptr.False()),
+		},
+		{
+			name:        "permanent error",
+			vs:          vsWithError(stringPtr("rpc error: code = InvalidArgument desc = fake-error"), boolptr.False()),
+			expectedErr: "VolumeSnapshot fake-ns/fake-vs failed: rpc error: code = InvalidArgument desc = fake-error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := GetVolumeSnapshotFailure(test.vs)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, test.expectedErr)
+
+			var failure *VolumeSnapshotFailure
+			require.ErrorAs(t, err, &failure)
+			assert.Equal(t, "fake-ns", failure.Namespace)
+			assert.Equal(t, "fake-vs", failure.Name)
+		})
+	}
+}
+
 func TestGetVolumeSnapshotContentForVolumeSnapshot(t *testing.T) {
 	vscName := "fake-vsc"
 	vsObj := &snapshotv1api.VolumeSnapshot{
@@ -135,10 +135,18 @@ func EnsureDeletePod(ctx context.Context, podGetter corev1client.CoreV1Interface
 	return nil
 }
 
+// podReasonDeadlineExceeded is the reason set by kubelet when a pod is failed for exceeding its activeDeadlineSeconds
+const podReasonDeadlineExceeded = "DeadlineExceeded"
+
 // IsPodUnrecoverable checks if the pod is in an abnormal state and could not be recovered
 // It could not cover all the cases but we could add more cases in the future
 func IsPodUnrecoverable(pod *corev1api.Pod, log logrus.FieldLogger) (bool, string) {
 	// Check the Phase field
+	if pod.Status.Phase == corev1api.PodFailed && pod.Status.Reason == podReasonDeadlineExceeded {
+		log.Warnf("Pod exceeded its active deadline, message [%s]", pod.Status.Message)
+		return true, fmt.Sprintf("Pod exceeded its active deadline, message [%s]", pod.Status.Message)
+	}
+
 	if pod.Status.Phase == corev1api.PodFailed || pod.Status.Phase == corev1api.PodUnknown {
 		message := GetPodTerminateMessage(pod)
 		log.Warnf("Pod is in abnormal state %s, message [%s]", pod.Status.Phase, message)
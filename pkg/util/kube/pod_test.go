@@ -409,6 +409,16 @@ func TestIsPodUnrecoverable(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "pod exceeded active deadline",
+			pod: &corev1api.Pod{
+				Status: corev1api.PodStatus{
+					Phase:  corev1api.PodFailed,
+					Reason: "DeadlineExceeded",
+				},
+			},
+			want: true,
+		},
 		{
 			name: "pod is in unknown state",
 			pod: &corev1api.Pod{
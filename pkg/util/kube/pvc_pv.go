@@ -395,7 +395,9 @@ func IsPVCBound(pvc *corev1api.PersistentVolumeClaim) bool {
 	return pvc.Spec.VolumeName != ""
 }
 
-// MakePodPVCAttachment returns the volume mounts and devices for a pod needed to attach a PVC
+// MakePodPVCAttachment makes the volume mounts or volume devices to attach the PVC volume to a pod's container.
+// For block mode, VolumeDevice has no read-only flag, the caller must set ReadOnly in the pod's PVC volume source
+// to attach the device as read-only
 func MakePodPVCAttachment(volumeName string, volumeMode *corev1api.PersistentVolumeMode, readOnly bool) ([]corev1api.VolumeMount, []corev1api.VolumeDevice, string) {
 	var volumeMounts []corev1api.VolumeMount
 	var volumeDevices []corev1api.VolumeDevice
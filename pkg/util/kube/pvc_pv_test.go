@@ -1555,6 +1555,19 @@ func TestMakePodPVCAttachment(t *testing.T) {
 			},
 			expectedVolumePath: "/volume-3",
 		},
+		{
+			name:       "block volume mode specified with readOnly as true",
+			volumeName: "volume-5",
+			volumeMode: corev1api.PersistentVolumeBlock,
+			readOnly:   true,
+			expectedVolumeDevice: []corev1api.VolumeDevice{
+				{
+					Name:       "volume-5",
+					DevicePath: "/volume-5",
+				},
+			},
+			expectedVolumePath: "/volume-5",
+		},
 		{
 			name:       "fs mode specified with readOnly as false",
 			volumeName: "volume-4",